@@ -63,3 +63,19 @@ func (bag *Bag[T]) Iterator() iter.Seq[T] {
 		}
 	}
 }
+
+// Remove removes one item for which match returns true from the Bag, and returns false if there is no such item.
+// The complexity is O(N), where N is the number of items in the Bag.
+func (bag *Bag[T]) Remove(match func(item T) bool) bool {
+	bag.lock.Lock()
+	defer bag.lock.Unlock()
+
+	for link := &bag.first; *link != nil; link = &(*link).next {
+		if match((*link).item) {
+			*link = (*link).next
+			bag.size--
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,272 @@
+package fundamental
+
+import (
+	"errors"
+	"sync"
+)
+
+// IndexMinPQ represents an indexed priority queue of generic keys. This implementation uses a binary heap along
+// with an array to associate keys with integers in the range 0 to maxN – 1.
+// It relies on the less() function to compare two keys.
+// It supports the usual Insert and DelMin operations, along with Delete and ChangeKey methods. In order to let the
+// client refer to keys on the priority queue, an integer between 0 and maxN – 1 is associated with each key—the
+// client uses this integer to specify which key to delete or change.
+// It also supports methods for peeking at the minimum key and testing if the priority queue is empty.
+// The Insert, DelMin, Delete, DecreaseKey, IncreaseKey and ChangeKey operations take O(log(N)) time,
+// where N is the number of items. The IsEmpty, Size, MinIndex, MinKey, Contains and KeyOf operations take
+// constant time.
+type IndexMinPQ[T any] struct {
+	lock *sync.Mutex       // protect race condition
+	n    int               // number of elements on IndexMinPQ
+	pq   []int             // binary heap using 1-based indexing
+	qp   []int             // inverse of pq: qp[pq[i]] = pq[qp[i]] = i
+	keys []T               // keys[i] = priority of i
+	less func(a, b T) bool // function to compare two keys
+}
+
+// NewIndexMinPQ initializes an empty indexed priority queue with indices between 0 and maxN - 1.
+// It gets a function as a parameter to compare two keys.
+// The complexity is O(maxN).
+func NewIndexMinPQ[T any](maxN int, less func(a, b T) bool) (*IndexMinPQ[T], error) {
+	if maxN < 0 {
+		return nil, ErrInvalidIndex
+	}
+	qp := make([]int, maxN+1)
+	for i := range qp {
+		qp[i] = -1
+	}
+	return &IndexMinPQ[T]{
+		lock: &sync.Mutex{},
+		n:    0,
+		pq:   make([]int, maxN+1),
+		qp:   qp,
+		keys: make([]T, maxN+1),
+		less: less,
+	}, nil
+}
+
+var ErrIndexAlreadyInPriorityQueue = errors.New("index is already in the priority queue")
+var ErrIndexNotInPriorityQueue = errors.New("index is not in the priority queue")
+var ErrInvalidKeyChange = errors.New("key change does not respect the requested direction")
+
+// IsEmpty returns true if this IndexMinPQ is empty.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) IsEmpty() bool {
+	return minPQ.n == 0
+}
+
+// Size returns the number of keys in this IndexMinPQ.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) Size() int {
+	return minPQ.n
+}
+
+// Contains returns true if i is an index on this IndexMinPQ.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) Contains(i int) bool {
+	if minPQ.validate(i) != nil {
+		return false
+	}
+	return minPQ.qp[i] != -1
+}
+
+// Insert associates key with index i, returns ErrIndexAlreadyInPriorityQueue if there already is an item
+// associated with index i.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) Insert(i int, key T) error {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if err := minPQ.validate(i); err != nil {
+		return err
+	}
+	if minPQ.qp[i] != -1 {
+		return ErrIndexAlreadyInPriorityQueue
+	}
+	minPQ.n++
+	minPQ.qp[i] = minPQ.n
+	minPQ.pq[minPQ.n] = i
+	minPQ.keys[i] = key
+	minPQ.swim(minPQ.n)
+	return nil
+}
+
+// MinIndex returns an index associated with a minimum key, ErrEmptyPriorityQueue if IndexMinPQ is empty.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) MinIndex() (int, error) {
+	if minPQ.IsEmpty() {
+		return -1, ErrEmptyPriorityQueue
+	}
+	return minPQ.pq[1], nil
+}
+
+// MinKey returns a minimum key, ErrEmptyPriorityQueue if IndexMinPQ is empty.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) MinKey() (T, error) {
+	if minPQ.IsEmpty() {
+		var key T
+		return key, ErrEmptyPriorityQueue
+	}
+	return minPQ.keys[minPQ.pq[1]], nil
+}
+
+// DelMin removes a minimum key and returns its associated index, ErrEmptyPriorityQueue if IndexMinPQ is empty.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) DelMin() (int, error) {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if minPQ.IsEmpty() {
+		return -1, ErrEmptyPriorityQueue
+	}
+	minIndex := minPQ.pq[1]
+	minPQ.exch(1, minPQ.n)
+	minPQ.n--
+	minPQ.sink(1)
+
+	// avoid loitering and help with garbage collection
+	var zero T
+	minPQ.qp[minIndex] = -1
+	minPQ.keys[minIndex] = zero
+	minPQ.pq[minPQ.n+1] = -1
+	return minIndex, nil
+}
+
+// KeyOf returns the key associated with index i, ErrIndexNotInPriorityQueue if no key is associated with index i.
+// The complexity is O(1).
+func (minPQ *IndexMinPQ[T]) KeyOf(i int) (T, error) {
+	var key T
+	if err := minPQ.validate(i); err != nil {
+		return key, err
+	}
+	if minPQ.qp[i] == -1 {
+		return key, ErrIndexNotInPriorityQueue
+	}
+	return minPQ.keys[i], nil
+}
+
+// ChangeKey changes the key associated with index i to the specified value,
+// ErrIndexNotInPriorityQueue if no key is associated with index i.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) ChangeKey(i int, key T) error {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if err := minPQ.validate(i); err != nil {
+		return err
+	}
+	if minPQ.qp[i] == -1 {
+		return ErrIndexNotInPriorityQueue
+	}
+	minPQ.keys[i] = key
+	minPQ.swim(minPQ.qp[i])
+	minPQ.sink(minPQ.qp[i])
+	return nil
+}
+
+// DecreaseKey decreases the key associated with index i to the specified value,
+// ErrIndexNotInPriorityQueue if no key is associated with index i and
+// ErrInvalidKeyChange if key is not strictly less than the key associated with index i.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) DecreaseKey(i int, key T) error {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if err := minPQ.validate(i); err != nil {
+		return err
+	}
+	if minPQ.qp[i] == -1 {
+		return ErrIndexNotInPriorityQueue
+	}
+	if !minPQ.less(key, minPQ.keys[i]) {
+		return ErrInvalidKeyChange
+	}
+	minPQ.keys[i] = key
+	minPQ.swim(minPQ.qp[i])
+	return nil
+}
+
+// IncreaseKey increases the key associated with index i to the specified value,
+// ErrIndexNotInPriorityQueue if no key is associated with index i and
+// ErrInvalidKeyChange if key is not strictly greater than the key associated with index i.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) IncreaseKey(i int, key T) error {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if err := minPQ.validate(i); err != nil {
+		return err
+	}
+	if minPQ.qp[i] == -1 {
+		return ErrIndexNotInPriorityQueue
+	}
+	if !minPQ.less(minPQ.keys[i], key) {
+		return ErrInvalidKeyChange
+	}
+	minPQ.keys[i] = key
+	minPQ.sink(minPQ.qp[i])
+	return nil
+}
+
+// Delete removes the key associated with index i, ErrIndexNotInPriorityQueue if no key is associated with index i.
+// The complexity is O(log(N)), where N is the number of keys.
+func (minPQ *IndexMinPQ[T]) Delete(i int) error {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	if err := minPQ.validate(i); err != nil {
+		return err
+	}
+	if minPQ.qp[i] == -1 {
+		return ErrIndexNotInPriorityQueue
+	}
+	index := minPQ.qp[i]
+	minPQ.exch(index, minPQ.n)
+	minPQ.n--
+	minPQ.swim(index)
+	minPQ.sink(index)
+
+	var zero T
+	minPQ.keys[i] = zero
+	minPQ.qp[i] = -1
+	return nil
+}
+
+// validate that i is a valid index
+func (minPQ *IndexMinPQ[T]) validate(i int) error {
+	if i < 0 || i >= len(minPQ.keys)-1 {
+		return ErrInvalidIndex
+	}
+	return nil
+}
+
+func (minPQ *IndexMinPQ[T]) greater(i, j int) bool {
+	return minPQ.less(minPQ.keys[minPQ.pq[j]], minPQ.keys[minPQ.pq[i]])
+}
+
+func (minPQ *IndexMinPQ[T]) exch(i, j int) {
+	minPQ.pq[i], minPQ.pq[j] = minPQ.pq[j], minPQ.pq[i]
+	minPQ.qp[minPQ.pq[i]] = i
+	minPQ.qp[minPQ.pq[j]] = j
+}
+
+func (minPQ *IndexMinPQ[T]) swim(k int) {
+	for k > 1 && minPQ.greater(k/2, k) {
+		minPQ.exch(k, k/2)
+		k = k / 2
+	}
+}
+
+func (minPQ *IndexMinPQ[T]) sink(k int) {
+	for 2*k <= minPQ.n {
+		j := 2 * k
+		if j < minPQ.n && minPQ.greater(j, j+1) {
+			j++
+		}
+		if !minPQ.greater(k, j) {
+			break
+		}
+		minPQ.exch(k, j)
+		k = j
+	}
+}
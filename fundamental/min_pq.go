@@ -0,0 +1,123 @@
+package fundamental
+
+import (
+	"errors"
+	"sync"
+)
+
+// MinPQ represents a priority queue of generic items. This implementation uses a binary heap.
+// It relies on the less() function to compare two items.
+// It supports the usual Insert and DelMin operations, along with methods for peeking at the
+// minimum item, getting the size of the MinPQ and testing if the MinPQ is empty.
+// The Insert and DelMin operations take O(log(N)) amortized time, where N is the number of items.
+// The Min, Size, and IsEmpty operations take constant time.
+type MinPQ[T any] struct {
+	lock *sync.Mutex       // protect race condition
+	pq   []T               // store items at indices 1 to n
+	n    int               // number of items on MinPQ
+	less func(a, b T) bool // function to compare two items
+}
+
+// NewMinPQ initializes an empty MinPQ.
+// It gets a function as a parameter to compare two items.
+// The complexity is O(1).
+func NewMinPQ[T any](less func(a, b T) bool) *MinPQ[T] {
+	return &MinPQ[T]{
+		lock: &sync.Mutex{},
+		pq:   make([]T, 1),
+		n:    0,
+		less: less,
+	}
+}
+
+var ErrEmptyPriorityQueue = errors.New("priority queue is empty")
+
+// IsEmpty returns true if this MinPQ is empty.
+// The complexity is O(1).
+func (minPQ *MinPQ[T]) IsEmpty() bool {
+	return minPQ.n == 0
+}
+
+// Size returns the number of items in this MinPQ.
+// The complexity is O(1).
+func (minPQ *MinPQ[T]) Size() int {
+	return minPQ.n
+}
+
+// Min returns (but does not remove) the smallest item on MinPQ,
+// returns ErrEmptyPriorityQueue if MinPQ is empty.
+// The complexity is O(1).
+func (minPQ *MinPQ[T]) Min() (T, error) {
+	var item T
+	if minPQ.IsEmpty() {
+		return item, ErrEmptyPriorityQueue
+	}
+	return minPQ.pq[1], nil
+}
+
+// Insert adds the item to this MinPQ.
+// The complexity is O(log(N)) amortized, where N is the number of items.
+func (minPQ *MinPQ[T]) Insert(item T) {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	minPQ.n++
+	if minPQ.n < len(minPQ.pq) {
+		minPQ.pq[minPQ.n] = item
+	} else {
+		minPQ.pq = append(minPQ.pq, item)
+	}
+	minPQ.swim(minPQ.n)
+}
+
+// DelMin removes and returns the smallest item on MinPQ,
+// returns ErrEmptyPriorityQueue if MinPQ is empty.
+// The complexity is O(log(N)) amortized, where N is the number of items.
+func (minPQ *MinPQ[T]) DelMin() (T, error) {
+	minPQ.lock.Lock()
+	defer minPQ.lock.Unlock()
+
+	var item T
+	if minPQ.IsEmpty() {
+		return item, ErrEmptyPriorityQueue
+	}
+	item = minPQ.pq[1]
+	minPQ.exch(1, minPQ.n)
+	minPQ.n--
+	minPQ.sink(1)
+
+	// avoid loitering and help with garbage collection
+	var zero T
+	minPQ.pq[minPQ.n+1] = zero
+
+	return item, nil
+}
+
+func (minPQ *MinPQ[T]) swim(k int) {
+	for k > 1 && minPQ.greater(k/2, k) {
+		minPQ.exch(k, k/2)
+		k = k / 2
+	}
+}
+
+func (minPQ *MinPQ[T]) sink(k int) {
+	for 2*k <= minPQ.n {
+		j := 2 * k
+		if j < minPQ.n && minPQ.greater(j, j+1) {
+			j++
+		}
+		if !minPQ.greater(k, j) {
+			break
+		}
+		minPQ.exch(k, j)
+		k = j
+	}
+}
+
+func (minPQ *MinPQ[T]) greater(i, j int) bool {
+	return minPQ.less(minPQ.pq[j], minPQ.pq[i])
+}
+
+func (minPQ *MinPQ[T]) exch(i, j int) {
+	minPQ.pq[i], minPQ.pq[j] = minPQ.pq[j], minPQ.pq[i]
+}
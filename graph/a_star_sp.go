@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// AStarSP represents a data type for solving the point-to-point shortest path problem in edge-weighted digraphs
+// where the edge weights are non-negative.
+// This implementation uses the A* search algorithm: it is Dijkstra's algorithm with vertices ordered by the distance
+// from the source vertex (s) plus a heuristic estimate of the remaining distance to the target vertex (t), and it
+// stops as soon as the target vertex is removed from the priority queue. The heuristic must be admissible (it never
+// overestimates the remaining distance), otherwise the path found may not be a shortest one.
+// It uses O(V) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type AStarSP struct {
+	distTo []float64                        // distTo[v] = distance of shortest known s->v path
+	edgeTo []*DirectedEdge                  // edgeTo[v] = last edge on shortest known s->v path
+	pq     *fundamental.IndexMinPQ[float64] // priority queue of vertices keyed by distTo[v] + heuristic(v)
+	t      int                              // target vertex
+}
+
+// NewAStarSP computes a shortest path from the source vertex (s) to the target vertex (t) in the edge-weighted
+// digraph guided by heuristic, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)) in the worst case, where V is the number of vertices and E is the number of edges,
+// though a good heuristic explores only a small part of the edge-weighted digraph.
+func NewAStarSP(digraph *EdgeWeightedDigraph, s, t int, heuristic func(v int) float64) (*AStarSP, error) {
+	if err := digraph.validateVertex(s); err != nil {
+		return nil, err
+	}
+	if err := digraph.validateVertex(t); err != nil {
+		return nil, err
+	}
+	for e := range digraph.Edges() {
+		if e.Weight() < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+
+	pq, _ := fundamental.NewIndexMinPQ[float64](digraph.V(), func(a, b float64) bool {
+		return a < b
+	})
+	a := &AStarSP{
+		distTo: make([]float64, digraph.V()),
+		edgeTo: make([]*DirectedEdge, digraph.V()),
+		pq:     pq,
+		t:      t,
+	}
+	for v := 0; v < digraph.V(); v++ {
+		a.distTo[v] = math.Inf(1)
+	}
+	a.distTo[s] = 0
+	a.pq.Insert(s, heuristic(s))
+
+	// relax vertices in order of estimated s->t distance, stop when the target is reached
+	for !a.pq.IsEmpty() {
+		v, _ := a.pq.DelMin()
+		if v == t {
+			break
+		}
+		adj, _ := digraph.Adj(v)
+		for e := range adj {
+			a.relax(e, heuristic)
+		}
+	}
+	return a, nil
+}
+
+var ErrNotTargetVertex = errors.New("vertex is not the target vertex")
+
+// relax edge e and update pq if changed
+func (a *AStarSP) relax(e DirectedEdge, heuristic func(v int) float64) {
+	v, w := e.From(), e.To()
+	if a.distTo[w] > a.distTo[v]+e.Weight() {
+		a.distTo[w] = a.distTo[v] + e.Weight()
+		a.edgeTo[w] = &e
+		if a.pq.Contains(w) {
+			a.pq.ChangeKey(w, a.distTo[w]+heuristic(w))
+		} else {
+			a.pq.Insert(w, a.distTo[w]+heuristic(w))
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v, ErrNotTargetVertex if v is not
+// the target vertex.
+// The complexity is O(1).
+func (a *AStarSP) HasPathTo(v int) (bool, error) {
+	if err := a.validateTarget(v); err != nil {
+		return false, err
+	}
+	return a.distTo[v] < math.Inf(1), nil
+}
+
+// DistTo returns the length of a shortest path between the source vertex and vertex v, or returns +Inf if there is
+// no path, ErrNotTargetVertex if v is not the target vertex.
+// The complexity is O(1).
+func (a *AStarSP) DistTo(v int) (float64, error) {
+	if err := a.validateTarget(v); err != nil {
+		return math.Inf(1), err
+	}
+	return a.distTo[v], nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a shortest path between the source vertex and
+// vertex v, ErrNotTargetVertex if v is not the target vertex.
+func (a *AStarSP) PathTo(v int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewStack[DirectedEdge]()
+	if err := a.validateTarget(v); err != nil {
+		return path.Iterator(), err
+	}
+	for e := a.edgeTo[v]; e != nil; e = a.edgeTo[e.From()] {
+		path.Push(*e)
+	}
+	return path.Iterator(), nil
+}
+
+// validateTarget validates that v is the target vertex, the only vertex whose distance is final after the search
+func (a *AStarSP) validateTarget(v int) error {
+	if v < 0 || v >= len(a.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	if v != a.t {
+		return ErrNotTargetVertex
+	}
+	return nil
+}
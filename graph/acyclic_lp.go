@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// AcyclicLP represents a data type for solving the single-source longest paths problem in edge-weighted directed
+// acyclic graphs (DAGs). The edge weights can be positive, negative, or zero.
+// This implementation uses a topological-sort based algorithm.
+// It uses O(V) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type AcyclicLP struct {
+	distTo []float64       // distTo[v] = distance of longest s->v path
+	edgeTo []*DirectedEdge // edgeTo[v] = last edge on longest s->v path
+}
+
+// NewAcyclicLP computes a longest paths tree from the source vertex (s) to every other vertex in the
+// directed acyclic graph, ErrNotDAG if the edge-weighted digraph is not a DAG.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewAcyclicLP(digraph *EdgeWeightedDigraph, s int) (*AcyclicLP, error) {
+	if err := digraph.validateVertex(s); err != nil {
+		return nil, err
+	}
+	topological := NewEdgeWeightedTopological(digraph)
+	order, err := topological.Order()
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AcyclicLP{
+		distTo: make([]float64, digraph.V()),
+		edgeTo: make([]*DirectedEdge, digraph.V()),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		a.distTo[v] = math.Inf(-1)
+	}
+	a.distTo[s] = 0
+
+	// visit vertices in topological order
+	for v := range order {
+		adj, _ := digraph.Adj(v)
+		for e := range adj {
+			a.relax(e)
+		}
+	}
+	return a, nil
+}
+
+// relax edge e
+func (a *AcyclicLP) relax(e DirectedEdge) {
+	v, w := e.From(), e.To()
+	if a.distTo[w] < a.distTo[v]+e.Weight() {
+		a.distTo[w] = a.distTo[v] + e.Weight()
+		a.edgeTo[w] = &e
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v.
+// The complexity is O(1).
+func (a *AcyclicLP) HasPathTo(v int) (bool, error) {
+	if err := a.validateVertex(v); err != nil {
+		return false, err
+	}
+	return a.distTo[v] > math.Inf(-1), nil
+}
+
+// DistTo returns the length of a longest path between the source vertex and vertex v, or
+// returns -Inf if there is no path.
+// The complexity is O(1).
+func (a *AcyclicLP) DistTo(v int) (float64, error) {
+	if err := a.validateVertex(v); err != nil {
+		return math.Inf(-1), err
+	}
+	return a.distTo[v], nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a longest path between the source vertex and vertex v.
+func (a *AcyclicLP) PathTo(v int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewStack[DirectedEdge]()
+	if hasPath, err := a.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+	for e := a.edgeTo[v]; e != nil; e = a.edgeTo[e.From()] {
+		path.Push(*e)
+	}
+	return path.Iterator(), nil
+}
+
+func (a *AcyclicLP) validateVertex(v int) error {
+	if v < 0 || v >= len(a.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package graph
+
+import "iter"
+
+// AdjMatrixDigraph represents a directed graph of vertices named 0 through v – 1. This implementation uses an
+// adjacency-matrix representation, which is a vertex-indexed two-dimensional array of bits, so that HasEdge takes
+// constant time. It is suited to dense digraphs.
+// Parallel edges are not permitted, adding an existing edge has no effect. Self-loops are permitted.
+// It uses O(V²) bits of space, where V is the number of vertices.
+type AdjMatrixDigraph struct {
+	v         int       // number of vertices
+	e         int       // number of edges
+	adj       bitMatrix // adj[v][w] = true if there is an edge v->w
+	inDegree  []int     // inDegree[v] = in-degree of vertex v
+	outDegree []int     // outDegree[v] = out-degree of vertex v
+}
+
+// NewAdjMatrixDigraph initializes a digraph with v number vertices
+// The complexity is O(V²), where V is the number of vertices.
+func NewAdjMatrixDigraph(v int) (*AdjMatrixDigraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	return &AdjMatrixDigraph{
+		v:         v,
+		e:         0,
+		adj:       newBitMatrix(v),
+		inDegree:  make([]int, v),
+		outDegree: make([]int, v),
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) V() int {
+	return digraph.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) E() int {
+	return digraph.e
+}
+
+func (digraph *AdjMatrixDigraph) validateVertex(v int) error {
+	if v < 0 || v >= digraph.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the directed edge v->w, if it does not already exist.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) AddEdge(v, w int) error {
+	if err := digraph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return err
+	}
+	if digraph.adj.get(v, w) {
+		return nil
+	}
+	digraph.e++
+	digraph.adj.set(v, w)
+	digraph.outDegree[v]++
+	digraph.inDegree[w]++
+	return nil
+}
+
+// RemoveEdge removes the directed edge v->w, ErrEdgeNotFound if there is no such edge.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) RemoveEdge(v, w int) error {
+	if err := digraph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return err
+	}
+	if !digraph.adj.get(v, w) {
+		return ErrEdgeNotFound
+	}
+	digraph.e--
+	digraph.adj.clear(v, w)
+	digraph.outDegree[v]--
+	digraph.inDegree[w]--
+	return nil
+}
+
+// HasEdge returns true if the digraph has the directed edge v->w.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) HasEdge(v, w int) (bool, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return false, err
+	}
+	return digraph.adj.get(v, w), nil
+}
+
+// Adj returns an iterator that iterates over vertices adjacent to vertex v, in ascending order.
+// The complexity is O(1) (Though, iterating over the vertices returned by Adj(v) takes time proportional to V/64 +
+// the out-degree of the vertex v).
+func (digraph *AdjMatrixDigraph) Adj(v int) (iter.Seq[int], error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return func(yield func(int) bool) {
+		digraph.adj.row(v, yield)
+	}, nil
+}
+
+// InDegree returns the in-degree of vertex v.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) InDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.inDegree[v], nil
+}
+
+// OutDegree returns the out-degree of vertex v.
+// The complexity is O(1).
+func (digraph *AdjMatrixDigraph) OutDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.outDegree[v], nil
+}
+
+// Reverse returns the reverse of the digraph.
+// The complexity is O(V²), where V is the number of vertices.
+func (digraph *AdjMatrixDigraph) Reverse() *AdjMatrixDigraph {
+	reverse, _ := NewAdjMatrixDigraph(digraph.v)
+	for v := 0; v < digraph.v; v++ {
+		digraph.adj.row(v, func(w int) bool {
+			reverse.AddEdge(w, v)
+			return true
+		})
+	}
+	return reverse
+}
@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"iter"
+)
+
+// AdjMatrixEdgeWeightedDigraph represents an edge-weighted digraph of vertices named 0 through v – 1, where each
+// directed edge is of type DirectedEdge and has a real-valued weight. This implementation uses an adjacency-matrix
+// representation, which is a vertex-indexed two-dimensional array of edges.
+// Parallel edges are not permitted, adding an edge v->w replaces the existing edge v->w (if any). Self-loops are
+// permitted.
+// It uses O(V²) space, where V is the number of vertices.
+type AdjMatrixEdgeWeightedDigraph struct {
+	v   int               // number of vertices
+	e   int               // number of edges
+	adj [][]*DirectedEdge // adj[v][w] = edge v->w or nil
+}
+
+// NewAdjMatrixEdgeWeightedDigraph initializes an edge-weighted digraph with v number vertices
+// The complexity is O(V²), where V is the number of vertices.
+func NewAdjMatrixEdgeWeightedDigraph(v int) (*AdjMatrixEdgeWeightedDigraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	adj := make([][]*DirectedEdge, v)
+	for i := 0; i < v; i++ {
+		adj[i] = make([]*DirectedEdge, v)
+	}
+
+	return &AdjMatrixEdgeWeightedDigraph{
+		v:   v,
+		e:   0,
+		adj: adj,
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (digraph *AdjMatrixEdgeWeightedDigraph) V() int {
+	return digraph.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (digraph *AdjMatrixEdgeWeightedDigraph) E() int {
+	return digraph.e
+}
+
+func (digraph *AdjMatrixEdgeWeightedDigraph) validateVertex(v int) error {
+	if v < 0 || v >= digraph.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the directed edge e, replacing the existing edge with the same endpoints (if any).
+// The complexity is O(1).
+func (digraph *AdjMatrixEdgeWeightedDigraph) AddEdge(e DirectedEdge) error {
+	if err := digraph.validateVertex(e.From()); err != nil {
+		return err
+	}
+	if err := digraph.validateVertex(e.To()); err != nil {
+		return err
+	}
+	if digraph.adj[e.From()][e.To()] == nil {
+		digraph.e++
+	}
+	digraph.adj[e.From()][e.To()] = &e
+	return nil
+}
+
+// HasEdge returns true if the digraph has a directed edge v->w (of any weight).
+// The complexity is O(1).
+func (digraph *AdjMatrixEdgeWeightedDigraph) HasEdge(v, w int) (bool, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return false, err
+	}
+	return digraph.adj[v][w] != nil, nil
+}
+
+// Adj returns an iterator that iterates over directed edges leaving vertex v.
+// The complexity is O(1) (Though, iterating over the edges returned by Adj(v) takes time proportional to V).
+func (digraph *AdjMatrixEdgeWeightedDigraph) Adj(v int) (iter.Seq[DirectedEdge], error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return func(yield func(DirectedEdge) bool) {
+		for _, e := range digraph.adj[v] {
+			if e != nil && !yield(*e) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,129 @@
+package graph
+
+import "iter"
+
+// AdjMatrixGraph represents an undirected graph of vertices named 0 through v – 1. This implementation uses an
+// adjacency-matrix representation, which is a vertex-indexed two-dimensional array of bits, so that HasEdge takes
+// constant time. It is suited to dense graphs.
+// Parallel edges are not permitted, adding an existing edge has no effect. Self-loops are permitted. By convention,
+// a self-loop v-v appears in the adjacency list of v twice and contributes two to the degree of v.
+// It uses O(V²) bits of space, where V is the number of vertices.
+type AdjMatrixGraph struct {
+	v      int       // number of vertices
+	e      int       // number of edges
+	adj    bitMatrix // adj[v][w] = true if there is an edge v-w
+	degree []int     // degree[v] = degree of vertex v
+}
+
+// NewAdjMatrixGraph initializes a graph with v number vertices
+// The complexity is O(V²), where V is the number of vertices.
+func NewAdjMatrixGraph(v int) (*AdjMatrixGraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	return &AdjMatrixGraph{
+		v:      v,
+		e:      0,
+		adj:    newBitMatrix(v),
+		degree: make([]int, v),
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) V() int {
+	return graph.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) E() int {
+	return graph.e
+}
+
+func (graph *AdjMatrixGraph) validateVertex(v int) error {
+	if v < 0 || v >= graph.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the undirected edge v-w, if it does not already exist.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) AddEdge(v, w int) error {
+	if err := graph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return err
+	}
+	if graph.adj.get(v, w) {
+		return nil
+	}
+	graph.e++
+	graph.adj.set(v, w)
+	graph.adj.set(w, v)
+	graph.degree[v]++
+	graph.degree[w]++
+	return nil
+}
+
+// RemoveEdge removes the undirected edge v-w, ErrEdgeNotFound if there is no such edge.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) RemoveEdge(v, w int) error {
+	if err := graph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return err
+	}
+	if !graph.adj.get(v, w) {
+		return ErrEdgeNotFound
+	}
+	graph.e--
+	graph.adj.clear(v, w)
+	graph.adj.clear(w, v)
+	graph.degree[v]--
+	graph.degree[w]--
+	return nil
+}
+
+// HasEdge returns true if the graph has the edge v-w.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) HasEdge(v, w int) (bool, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return false, err
+	}
+	return graph.adj.get(v, w), nil
+}
+
+// Adj returns an iterator that iterates over vertices adjacent to vertex v, in ascending order.
+// The complexity is O(1) (Though, iterating over the vertices returned by Adj(v) takes time proportional to V/64 +
+// the degree of the vertex v).
+func (graph *AdjMatrixGraph) Adj(v int) (iter.Seq[int], error) {
+	if err := graph.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return func(yield func(int) bool) {
+		graph.adj.row(v, func(w int) bool {
+			// a self-loop v-v appears twice
+			if w == v && !yield(w) {
+				return false
+			}
+			return yield(w)
+		})
+	}, nil
+}
+
+// Degree returns the degree of vertex v.
+// The complexity is O(1).
+func (graph *AdjMatrixGraph) Degree(v int) (int, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return graph.degree[v], nil
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// BellmanFordSP represents a data type for solving the single-source shortest paths problem in edge-weighted
+// digraphs with no negative cycles. The edge weights can be positive, negative, or zero.
+// This implementation also determines whether there is a negative cycle reachable from the source vertex
+// and, if so, finds such a cycle.
+// This implementation uses a queue-based implementation of the Bellman-Ford-Moore algorithm.
+// It uses O(V) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type BellmanFordSP struct {
+	distTo  []float64                        // distTo[v] = distance of shortest s->v path
+	edgeTo  []*DirectedEdge                  // edgeTo[v] = last edge on shortest s->v path
+	onQueue []bool                           // onQueue[v] = is v currently on the queue?
+	queue   *fundamental.Queue[int]          // queue of vertices to relax
+	cost    int                              // number of calls to relax()
+	cycle   *fundamental.Stack[DirectedEdge] // negative cycle (or empty if no such cycle)
+}
+
+// NewBellmanFordSP computes a shortest-paths tree from the source vertex (s) to every other vertex in the
+// edge-weighted digraph, or finds a negative cycle reachable from s.
+// The complexity is O(V*E) in the worst case, where V is the number of vertices and E is the number of edges.
+func NewBellmanFordSP(digraph *EdgeWeightedDigraph, s int) (*BellmanFordSP, error) {
+	if err := digraph.validateVertex(s); err != nil {
+		return nil, err
+	}
+	b := &BellmanFordSP{
+		distTo:  make([]float64, digraph.V()),
+		edgeTo:  make([]*DirectedEdge, digraph.V()),
+		onQueue: make([]bool, digraph.V()),
+		queue:   fundamental.NewQueue[int](),
+		cost:    0,
+		cycle:   fundamental.NewStack[DirectedEdge](),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		b.distTo[v] = math.Inf(1)
+	}
+	b.distTo[s] = 0
+
+	// Bellman-Ford algorithm
+	b.queue.Enqueue(s)
+	b.onQueue[s] = true
+	for !b.queue.IsEmpty() && !b.HasNegativeCycle() {
+		v, _ := b.queue.Dequeue()
+		b.onQueue[v] = false
+		b.relax(digraph, v)
+	}
+	return b, nil
+}
+
+var ErrNegativeCycle = errors.New("negative cost cycle exists")
+
+// relax vertex v and put other endpoints on queue if changed
+func (b *BellmanFordSP) relax(digraph *EdgeWeightedDigraph, v int) {
+	adj, _ := digraph.Adj(v)
+	for e := range adj {
+		w := e.To()
+		if b.distTo[w] > b.distTo[v]+e.Weight() {
+			b.distTo[w] = b.distTo[v] + e.Weight()
+			b.edgeTo[w] = &e
+			if !b.onQueue[w] {
+				b.queue.Enqueue(w)
+				b.onQueue[w] = true
+			}
+		}
+		b.cost++
+		if b.cost%digraph.V() == 0 {
+			b.findNegativeCycle()
+			if b.HasNegativeCycle() {
+				// found a negative cycle
+				return
+			}
+		}
+	}
+}
+
+// findNegativeCycle by finding a cycle in predecessor graph
+func (b *BellmanFordSP) findNegativeCycle() {
+	spt, _ := NewEdgeWeightedDigraph(len(b.distTo))
+	for v := 0; v < len(b.edgeTo); v++ {
+		if b.edgeTo[v] != nil {
+			spt.AddEdge(*b.edgeTo[v])
+		}
+	}
+	finder := NewEdgeWeightedDirectedCycle(spt)
+	b.cycle = finder.cycle
+}
+
+// HasNegativeCycle returns true if there is a negative cycle reachable from the source vertex.
+// The complexity is O(1).
+func (b *BellmanFordSP) HasNegativeCycle() bool {
+	return !b.cycle.IsEmpty()
+}
+
+// NegativeCycle returns an iterator that iterates over the edges of a negative cycle reachable from
+// the source vertex, or an empty iterator if there is no such cycle.
+// The complexity is O(1).
+func (b *BellmanFordSP) NegativeCycle() iter.Seq[DirectedEdge] {
+	return b.cycle.Iterator()
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v,
+// ErrNegativeCycle if there is a negative cost cycle reachable from the source vertex.
+// The complexity is O(1).
+func (b *BellmanFordSP) HasPathTo(v int) (bool, error) {
+	if err := b.validateVertex(v); err != nil {
+		return false, err
+	}
+	if b.HasNegativeCycle() {
+		return false, ErrNegativeCycle
+	}
+	return b.distTo[v] < math.Inf(1), nil
+}
+
+// DistTo returns the length of a shortest path between the source vertex and vertex v, or returns +Inf if there
+// is no path, ErrNegativeCycle if there is a negative cost cycle reachable from the source vertex.
+// The complexity is O(1).
+func (b *BellmanFordSP) DistTo(v int) (float64, error) {
+	if err := b.validateVertex(v); err != nil {
+		return math.Inf(1), err
+	}
+	if b.HasNegativeCycle() {
+		return math.Inf(-1), ErrNegativeCycle
+	}
+	return b.distTo[v], nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a shortest path between the source vertex and vertex v,
+// ErrNegativeCycle if there is a negative cost cycle reachable from the source vertex.
+func (b *BellmanFordSP) PathTo(v int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewStack[DirectedEdge]()
+	if hasPath, err := b.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+	for e := b.edgeTo[v]; e != nil; e = b.edgeTo[e.From()] {
+		path.Push(*e)
+	}
+	return path.Iterator(), nil
+}
+
+func (b *BellmanFordSP) validateVertex(v int) error {
+	if v < 0 || v >= len(b.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
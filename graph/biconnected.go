@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// Biconnected represents a data type for determining the bridges, the articulation points (or cut vertices) and
+// the biconnected components (or blocks) of an undirected graph.
+// A bridge is an edge whose removal increases the number of connected components. An articulation point is a vertex
+// whose removal increases the number of connected components. A block is a maximal subgraph with no articulation
+// point of its own, every edge belongs to exactly one block, and by convention an isolated vertex forms a block on
+// its own.
+// Parallel edges and self-loops are handled: an edge with a parallel copy is never a bridge, and self-loops are
+// ignored since they do not affect connectivity.
+// This implementation uses depth-first search with low numbers and a stack of edges.
+// It uses O(V + E) extra space (not including the graph), where V is the number of vertices and E is the number
+// of edges.
+type Biconnected struct {
+	pre          []int                      // pre[v] = order in which dfs examines v, -1 if not yet examined
+	low          []int                      // low[v] = lowest preorder of any vertex connected to v
+	counter      int                        // preorder number counter
+	articulation []bool                     // articulation[v] = is v an articulation point?
+	bridges      *fundamental.Queue[[2]int] // bridges v-w
+	edgeStack    *fundamental.Stack[[2]int] // edges of the blocks not yet identified
+	blocks       []*fundamental.Queue[int]  // blocks[i] = vertices of block i
+	stamp        []int                      // stamp[v] = 1 + last block to which v has been added
+	blockOf      []int                      // blockOf[v] = a block containing v
+	tree         *Graph                     // block-cut tree
+	treeVertex   []int                      // treeVertex[v] = block-cut tree vertex of articulation point v
+}
+
+// NewBiconnected computes the bridges, articulation points and blocks of the graph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewBiconnected(graph AdjacencyGraph) *Biconnected {
+	b := &Biconnected{
+		pre:          make([]int, graph.V()),
+		low:          make([]int, graph.V()),
+		counter:      0,
+		articulation: make([]bool, graph.V()),
+		bridges:      fundamental.NewQueue[[2]int](),
+		edgeStack:    fundamental.NewStack[[2]int](),
+		stamp:        make([]int, graph.V()),
+		blockOf:      make([]int, graph.V()),
+		treeVertex:   make([]int, graph.V()),
+	}
+	for v := 0; v < graph.V(); v++ {
+		b.pre[v] = -1
+	}
+	for v := 0; v < graph.V(); v++ {
+		if b.pre[v] == -1 {
+			b.dfs(graph, v, -1)
+			if b.stamp[v] == 0 {
+				// v is isolated (apart from self-loops)
+				b.addBlock()
+				b.addToBlock(v)
+			}
+		}
+	}
+	b.buildBlockCutTree()
+	return b
+}
+
+var ErrInvalidBlockIndex = errors.New("invalid block index")
+
+// dfs (depth first search) from u, where parent is the vertex from which u is reached (-1 for a root)
+func (b *Biconnected) dfs(graph AdjacencyGraph, u, parent int) {
+	b.pre[u] = b.counter
+	b.low[u] = b.counter
+	b.counter++
+	children := 0
+	skippedParent := false
+	adj, _ := graph.Adj(u)
+	for w := range adj {
+		// self-loops do not affect connectivity
+		if w == u {
+			continue
+		}
+		// skip the tree edge to parent only once, a parallel copy of it is a back edge
+		if w == parent && !skippedParent {
+			skippedParent = true
+			continue
+		}
+
+		if b.pre[w] == -1 {
+			children++
+			b.edgeStack.Push([2]int{u, w})
+			b.dfs(graph, w, u)
+			b.low[u] = min(b.low[u], b.low[w])
+			if b.low[w] > b.pre[u] {
+				b.bridges.Enqueue([2]int{u, w})
+			}
+			if b.low[w] >= b.pre[u] {
+				// u separates the subtree of w, pop the block off the stack
+				if parent != -1 {
+					b.articulation[u] = true
+				}
+				b.addBlock()
+				for {
+					e, _ := b.edgeStack.Pop()
+					b.addToBlock(e[0])
+					b.addToBlock(e[1])
+					if e[0] == u && e[1] == w {
+						break
+					}
+				}
+			}
+		} else if b.pre[w] < b.pre[u] {
+			// back edge
+			b.edgeStack.Push([2]int{u, w})
+			b.low[u] = min(b.low[u], b.pre[w])
+		}
+	}
+
+	// a root is an articulation point if and only if it has more than one child
+	if parent == -1 && children > 1 {
+		b.articulation[u] = true
+	}
+}
+
+// addBlock starts a new empty block
+func (b *Biconnected) addBlock() {
+	b.blocks = append(b.blocks, fundamental.NewQueue[int]())
+}
+
+// addToBlock adds v to the last block if it is not already there
+func (b *Biconnected) addToBlock(v int) {
+	i := len(b.blocks) - 1
+	if b.stamp[v] == i+1 {
+		return
+	}
+	b.stamp[v] = i + 1
+	b.blockOf[v] = i
+	b.blocks[i].Enqueue(v)
+}
+
+// buildBlockCutTree builds the graph with a vertex per block and per articulation point, and an edge between a block
+// and each articulation point it contains
+func (b *Biconnected) buildBlockCutTree() {
+	n := len(b.blocks)
+	for v := 0; v < len(b.articulation); v++ {
+		b.treeVertex[v] = -1
+		if b.articulation[v] {
+			b.treeVertex[v] = n
+			n++
+		}
+	}
+	b.tree, _ = NewGraph(n)
+	for i, block := range b.blocks {
+		for v := range block.Iterator() {
+			if b.articulation[v] {
+				b.tree.AddEdge(i, b.treeVertex[v])
+			}
+		}
+	}
+}
+
+// IsArticulation returns true if vertex v is an articulation point.
+// The complexity is O(1).
+func (b *Biconnected) IsArticulation(v int) (bool, error) {
+	if err := b.validateVertex(v); err != nil {
+		return false, err
+	}
+	return b.articulation[v], nil
+}
+
+// ArticulationPoints returns an iterator that iterates over the articulation points in increasing order.
+// The complexity is O(1) (Though, iterating over the articulation points takes time proportional to V).
+func (b *Biconnected) ArticulationPoints() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v := 0; v < len(b.articulation); v++ {
+			if b.articulation[v] && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Bridges returns an iterator that iterates over the bridges, each as a pair of its endpoints.
+// The complexity is O(1).
+func (b *Biconnected) Bridges() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for e := range b.bridges.Iterator() {
+			if !yield(e[0], e[1]) {
+				return
+			}
+		}
+	}
+}
+
+// Count returns the number of blocks.
+// The complexity is O(1).
+func (b *Biconnected) Count() int {
+	return len(b.blocks)
+}
+
+// Block returns an iterator that iterates over the vertices of block i, where i is between 0 and Count() - 1.
+// The complexity is O(1).
+func (b *Biconnected) Block(i int) (iter.Seq[int], error) {
+	if i < 0 || i >= len(b.blocks) {
+		return nil, ErrInvalidBlockIndex
+	}
+	return b.blocks[i].Iterator(), nil
+}
+
+// BlockCutTree returns the block-cut tree (a forest if the graph is not connected). Vertices 0 through Count() - 1
+// are the blocks, and the following vertices are the articulation points (see BlockCutVertex). There is an edge
+// between a block and each articulation point it contains.
+// The complexity is O(1).
+func (b *Biconnected) BlockCutTree() *Graph {
+	return b.tree
+}
+
+// BlockCutVertex returns the vertex of the block-cut tree that represents vertex v: the articulation point vertex
+// if v is an articulation point, otherwise the only block that contains v.
+// The complexity is O(1).
+func (b *Biconnected) BlockCutVertex(v int) (int, error) {
+	if err := b.validateVertex(v); err != nil {
+		return -1, err
+	}
+	if b.articulation[v] {
+		return b.treeVertex[v], nil
+	}
+	return b.blockOf[v], nil
+}
+
+func (b *Biconnected) validateVertex(v int) error {
+	if v < 0 || v >= len(b.pre) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
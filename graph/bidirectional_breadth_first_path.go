@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// BidirectionalBreadthFirstPath represents a data type for finding a shortest path (in number of edges) from a
+// source vertex (s) to a target vertex (t) in a digraph.
+// This implementation uses bidirectional breadth-first search (BFS): a forward search from s in the digraph and a
+// backward search from t in its reverse, expanding one whole level of the smaller frontier at a time and stopping
+// as soon as the two searches meet.
+// It uses O(V + E) extra space (including the reverse of the digraph), where V is the number of vertices and E is
+// the number of edges.
+type BidirectionalBreadthFirstPath struct {
+	forwardDistTo  []int // forwardDistTo[v] = number of edges in shortest s->v path, -1 if not visited
+	forwardEdgeTo  []int // forwardEdgeTo[v] = previous vertex on shortest s->v path
+	backwardDistTo []int // backwardDistTo[v] = number of edges in shortest v->t path, -1 if not visited
+	backwardEdgeTo []int // backwardEdgeTo[v] = next vertex on shortest v->t path
+	dist           int   // number of edges in shortest s->t path, -1 if no path
+	meet           int   // vertex where the forward and backward paths meet
+	t              int   // target vertex
+}
+
+// NewBidirectionalBreadthFirstPath computes a shortest path from the source vertex (s) to the target vertex (t) in
+// the digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewBidirectionalBreadthFirstPath(digraph AdjacencyGraph, s, t int) (*BidirectionalBreadthFirstPath, error) {
+	if err := validateVertex(digraph, s); err != nil {
+		return nil, err
+	}
+	if err := validateVertex(digraph, t); err != nil {
+		return nil, err
+	}
+	b := &BidirectionalBreadthFirstPath{
+		forwardDistTo:  make([]int, digraph.V()),
+		forwardEdgeTo:  make([]int, digraph.V()),
+		backwardDistTo: make([]int, digraph.V()),
+		backwardEdgeTo: make([]int, digraph.V()),
+		dist:           -1,
+		meet:           -1,
+		t:              t,
+	}
+	for v := 0; v < digraph.V(); v++ {
+		b.forwardDistTo[v] = -1
+		b.backwardDistTo[v] = -1
+	}
+	b.forwardDistTo[s] = 0
+	b.backwardDistTo[t] = 0
+	if s == t {
+		b.dist = 0
+		b.meet = s
+		return b, nil
+	}
+
+	forwardQueue := fundamental.NewQueue[int]()
+	backwardQueue := fundamental.NewQueue[int]()
+	forwardQueue.Enqueue(s)
+	backwardQueue.Enqueue(t)
+	reversed := reverse(digraph)
+
+	for !forwardQueue.IsEmpty() && !backwardQueue.IsEmpty() && b.dist == -1 {
+		if forwardQueue.Size() <= backwardQueue.Size() {
+			b.expandLevel(digraph, forwardQueue, b.forwardDistTo, b.forwardEdgeTo, b.backwardDistTo)
+		} else {
+			b.expandLevel(reversed, backwardQueue, b.backwardDistTo, b.backwardEdgeTo, b.forwardDistTo)
+		}
+	}
+	return b, nil
+}
+
+// expandLevel (breadth first search) visits all vertices of the current level of one side, and records the
+// shortest path through each newly visited vertex that has already been visited by the other side
+func (b *BidirectionalBreadthFirstPath) expandLevel(digraph AdjacencyGraph, q *fundamental.Queue[int], distTo, edgeTo, otherDistTo []int) {
+	for n := q.Size(); n > 0; n-- {
+		v, _ := q.Dequeue()
+		adj, _ := digraph.Adj(v)
+		for w := range adj {
+			if distTo[w] != -1 {
+				continue
+			}
+			distTo[w] = distTo[v] + 1
+			edgeTo[w] = v
+			q.Enqueue(w)
+			if otherDistTo[w] != -1 && (b.dist == -1 || distTo[w]+otherDistTo[w] < b.dist) {
+				b.dist = distTo[w] + otherDistTo[w]
+				b.meet = w
+			}
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v, ErrNotTargetVertex if v is not
+// the target vertex.
+// The complexity is O(1).
+func (b *BidirectionalBreadthFirstPath) HasPathTo(v int) (bool, error) {
+	if err := b.validateTarget(v); err != nil {
+		return false, err
+	}
+	return b.dist != -1, nil
+}
+
+// DistTo returns the number of edges in a shortest path between the source vertex and vertex v, or returns -1 if
+// there is no path, ErrNotTargetVertex if v is not the target vertex.
+// The complexity is O(1).
+func (b *BidirectionalBreadthFirstPath) DistTo(v int) (int, error) {
+	if err := b.validateTarget(v); err != nil {
+		return -1, err
+	}
+	return b.dist, nil
+}
+
+// PathTo returns an iterator that iterates over a shortest path between the source vertex and vertex v,
+// ErrNotTargetVertex if v is not the target vertex.
+func (b *BidirectionalBreadthFirstPath) PathTo(v int) (iter.Seq[int], error) {
+	path := fundamental.NewQueue[int]()
+	if hasPath, err := b.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+
+	// s->meet part from the forward search
+	stack := fundamental.NewStack[int]()
+	x := b.meet
+	for ; b.forwardDistTo[x] != 0; x = b.forwardEdgeTo[x] {
+		stack.Push(x)
+	}
+	stack.Push(x)
+	for x := range stack.Iterator() {
+		path.Enqueue(x)
+	}
+
+	// meet->t part from the backward search
+	for x = b.meet; b.backwardDistTo[x] != 0; x = b.backwardEdgeTo[x] {
+		path.Enqueue(b.backwardEdgeTo[x])
+	}
+	return path.Iterator(), nil
+}
+
+// validateTarget validates that v is the target vertex
+func (b *BidirectionalBreadthFirstPath) validateTarget(v int) error {
+	if v < 0 || v >= len(b.forwardDistTo) {
+		return ErrInvalidVertexIndex
+	}
+	if v != b.t {
+		return ErrNotTargetVertex
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// BidirectionalDijkstraSP represents a data type for solving the point-to-point shortest path problem in
+// edge-weighted digraphs where the edge weights are non-negative.
+// This implementation uses bidirectional Dijkstra's algorithm: a forward search from the source vertex (s) in the
+// edge-weighted digraph and a backward search from the target vertex (t) in its reverse, which stops as soon as the
+// sum of the smallest keys of both priority queues is not less than the length of the best path found so far.
+// It uses O(V + E) extra space (including the reverse of the edge-weighted digraph), where V is the number of
+// vertices and E is the number of edges.
+type BidirectionalDijkstraSP struct {
+	forward  *bidirectionalDijkstraSearch // search from s in the edge-weighted digraph
+	backward *bidirectionalDijkstraSearch // search from t in the reverse of the edge-weighted digraph
+	dist     float64                      // length of the best s->t path found so far
+	meet     int                          // vertex where the forward and backward paths of the best path meet
+	t        int                          // target vertex
+}
+
+// bidirectionalDijkstraSearch a helper for one side of the bidirectional search.
+type bidirectionalDijkstraSearch struct {
+	digraph *EdgeWeightedDigraph
+	distTo  []float64
+	edgeTo  []*DirectedEdge
+	pq      *fundamental.IndexMinPQ[float64]
+}
+
+func newBidirectionalDijkstraSearch(digraph *EdgeWeightedDigraph, s int) *bidirectionalDijkstraSearch {
+	pq, _ := fundamental.NewIndexMinPQ[float64](digraph.V(), func(a, b float64) bool {
+		return a < b
+	})
+	b := &bidirectionalDijkstraSearch{
+		digraph: digraph,
+		distTo:  make([]float64, digraph.V()),
+		edgeTo:  make([]*DirectedEdge, digraph.V()),
+		pq:      pq,
+	}
+	for v := 0; v < digraph.V(); v++ {
+		b.distTo[v] = math.Inf(1)
+	}
+	b.distTo[s] = 0
+	b.pq.Insert(s, 0)
+	return b
+}
+
+// NewBidirectionalDijkstraSP computes a shortest path from the source vertex (s) to the target vertex (t) in the
+// edge-weighted digraph, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)) in the worst case, where V is the number of vertices and E is the number of edges.
+func NewBidirectionalDijkstraSP(digraph *EdgeWeightedDigraph, s, t int) (*BidirectionalDijkstraSP, error) {
+	if err := digraph.validateVertex(s); err != nil {
+		return nil, err
+	}
+	if err := digraph.validateVertex(t); err != nil {
+		return nil, err
+	}
+	for e := range digraph.Edges() {
+		if e.Weight() < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+
+	b := &BidirectionalDijkstraSP{
+		forward:  newBidirectionalDijkstraSearch(digraph, s),
+		backward: newBidirectionalDijkstraSearch(digraph.Reverse(), t),
+		dist:     math.Inf(1),
+		meet:     -1,
+		t:        t,
+	}
+	if s == t {
+		b.dist = 0
+		b.meet = s
+		return b, nil
+	}
+
+	// alternate between the two searches, always advancing the one with the smaller key
+	for !b.forward.pq.IsEmpty() && !b.backward.pq.IsEmpty() {
+		forwardMin, _ := b.forward.pq.MinKey()
+		backwardMin, _ := b.backward.pq.MinKey()
+		if forwardMin+backwardMin >= b.dist {
+			break
+		}
+		if forwardMin <= backwardMin {
+			b.scan(b.forward, b.backward)
+		} else {
+			b.scan(b.backward, b.forward)
+		}
+	}
+	return b, nil
+}
+
+// scan removes the closest vertex of search and relaxes its edges, updating the best path if search meets other
+func (b *BidirectionalDijkstraSP) scan(search, other *bidirectionalDijkstraSearch) {
+	v, _ := search.pq.DelMin()
+	adj, _ := search.digraph.Adj(v)
+	for e := range adj {
+		w := e.To()
+		if search.distTo[w] > search.distTo[v]+e.Weight() {
+			search.distTo[w] = search.distTo[v] + e.Weight()
+			search.edgeTo[w] = &e
+			if search.pq.Contains(w) {
+				search.pq.DecreaseKey(w, search.distTo[w])
+			} else {
+				search.pq.Insert(w, search.distTo[w])
+			}
+			if search.distTo[w]+other.distTo[w] < b.dist {
+				b.dist = search.distTo[w] + other.distTo[w]
+				b.meet = w
+			}
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v, ErrNotTargetVertex if v is not
+// the target vertex.
+// The complexity is O(1).
+func (b *BidirectionalDijkstraSP) HasPathTo(v int) (bool, error) {
+	if err := b.validateTarget(v); err != nil {
+		return false, err
+	}
+	return b.dist < math.Inf(1), nil
+}
+
+// DistTo returns the length of a shortest path between the source vertex and vertex v, or returns +Inf if there is
+// no path, ErrNotTargetVertex if v is not the target vertex.
+// The complexity is O(1).
+func (b *BidirectionalDijkstraSP) DistTo(v int) (float64, error) {
+	if err := b.validateTarget(v); err != nil {
+		return math.Inf(1), err
+	}
+	return b.dist, nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a shortest path between the source vertex and
+// vertex v, ErrNotTargetVertex if v is not the target vertex.
+func (b *BidirectionalDijkstraSP) PathTo(v int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewQueue[DirectedEdge]()
+	if hasPath, err := b.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+
+	// s->meet part from the forward search
+	stack := fundamental.NewStack[DirectedEdge]()
+	for e := b.forward.edgeTo[b.meet]; e != nil; e = b.forward.edgeTo[e.From()] {
+		stack.Push(*e)
+	}
+	for e := range stack.Iterator() {
+		path.Enqueue(e)
+	}
+
+	// meet->t part from the backward search, edges of the reverse digraph are flipped back
+	for e := b.backward.edgeTo[b.meet]; e != nil; e = b.backward.edgeTo[e.From()] {
+		original, _ := NewDirectedEdge(e.To(), e.From(), e.Weight())
+		path.Enqueue(original)
+	}
+	return path.Iterator(), nil
+}
+
+// validateTarget validates that v is the target vertex
+func (b *BidirectionalDijkstraSP) validateTarget(v int) error {
+	if v < 0 || v >= len(b.forward.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	if v != b.t {
+		return ErrNotTargetVertex
+	}
+	return nil
+}
@@ -20,7 +20,7 @@ type Bipartite struct {
 
 // NewBipartite determines whether an undirected graph is bipartite and finds either a bipartition or an odd-length cycle.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewBipartite(graph *Graph) *Bipartite {
+func NewBipartite(graph AdjacencyGraph) *Bipartite {
 	b := &Bipartite{
 		isBipartite: true,
 		color:       make([]bool, graph.V()),
@@ -39,7 +39,7 @@ func NewBipartite(graph *Graph) *Bipartite {
 var ErrGraphIsNotBipartite = errors.New("graph is not bipartite")
 
 // bfs (breadth first search) from s
-func (b *Bipartite) bfs(graph *Graph, s int) {
+func (b *Bipartite) bfs(graph AdjacencyGraph, s int) {
 	q := fundamental.NewQueue[int]()
 	b.color[s] = false
 	b.marked[s] = true
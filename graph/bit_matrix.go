@@ -0,0 +1,43 @@
+package graph
+
+import "math/bits"
+
+// bitMatrix is a square n-by-n matrix of bits, stored row by row in 64-bit words.
+// It is shared by AdjMatrixGraph and AdjMatrixDigraph.
+type bitMatrix struct {
+	words int      // number of words per row
+	bits  []uint64 // bits[v*words + w/64] holds the bit (v, w) at position w%64
+}
+
+func newBitMatrix(n int) bitMatrix {
+	words := (n + 63) / 64
+	return bitMatrix{
+		words: words,
+		bits:  make([]uint64, n*words),
+	}
+}
+
+func (m *bitMatrix) get(v, w int) bool {
+	return m.bits[v*m.words+w/64]&(1<<(w%64)) != 0
+}
+
+func (m *bitMatrix) set(v, w int) {
+	m.bits[v*m.words+w/64] |= 1 << (w % 64)
+}
+
+func (m *bitMatrix) clear(v, w int) {
+	m.bits[v*m.words+w/64] &^= 1 << (w % 64)
+}
+
+// row calls yield for each set bit w of row v in ascending order, until yield returns false
+func (m *bitMatrix) row(v int, yield func(w int) bool) bool {
+	for i, word := range m.bits[v*m.words : (v+1)*m.words] {
+		for word != 0 {
+			if !yield(i*64 + bits.TrailingZeros64(word)) {
+				return false
+			}
+			word &= word - 1
+		}
+	}
+	return true
+}
@@ -16,8 +16,8 @@ type BreadthFirstPath struct {
 
 // NewBreadthFirstPath computes the shortest path between the source vertex (s) and every other vertex in graph.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewBreadthFirstPath(graph UndirectedOrDirectedGraph, s int) (*BreadthFirstPath, error) {
-	if err := graph.validateVertex(s); err != nil {
+func NewBreadthFirstPath(graph AdjacencyGraph, s int) (*BreadthFirstPath, error) {
+	if err := validateVertex(graph, s); err != nil {
 		return nil, err
 	}
 	b := &BreadthFirstPath{
@@ -31,9 +31,9 @@ func NewBreadthFirstPath(graph UndirectedOrDirectedGraph, s int) (*BreadthFirstP
 
 // NewBreadthFirstPathMultiSource computes the shortest path between any one of the source vertices and every other vertex in graph.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewBreadthFirstPathMultiSource(graph UndirectedOrDirectedGraph, sources []int) (*BreadthFirstPath, error) {
+func NewBreadthFirstPathMultiSource(graph AdjacencyGraph, sources []int) (*BreadthFirstPath, error) {
 	for i := 0; i < len(sources); i++ {
-		if err := graph.validateVertex(sources[i]); err != nil {
+		if err := validateVertex(graph, sources[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -47,7 +47,7 @@ func NewBreadthFirstPathMultiSource(graph UndirectedOrDirectedGraph, sources []i
 }
 
 // bfs (breadth first search) from a single source
-func (b *BreadthFirstPath) bfs(graph UndirectedOrDirectedGraph, s int) {
+func (b *BreadthFirstPath) bfs(graph AdjacencyGraph, s int) {
 	for v := 0; v < graph.V(); v++ {
 		b.distTo[v] = -1
 	}
@@ -70,7 +70,7 @@ func (b *BreadthFirstPath) bfs(graph UndirectedOrDirectedGraph, s int) {
 }
 
 // bfsMultiSource (breadth first search) from multiple sources
-func (b *BreadthFirstPath) bfsMultiSource(graph UndirectedOrDirectedGraph, sources []int) {
+func (b *BreadthFirstPath) bfsMultiSource(graph AdjacencyGraph, sources []int) {
 	for v := 0; v < graph.V(); v++ {
 		b.distTo[v] = -1
 	}
@@ -0,0 +1,47 @@
+package graph
+
+import "errors"
+
+var ErrInvalidComponent = errors.New("component id must be between 0 and the number of components - 1")
+
+// NewCondensation returns the condensation DAG of the digraph, given its strong components scc (as computed by
+// KosarajuSCC, TarjanSCC or GabowSCC on the same digraph). The vertices of the condensation DAG are the strong
+// component ids, and it has an edge i->j if and only if the digraph has an edge from a vertex in component i to a
+// vertex in component j (i != j). Parallel edges are not repeated in the condensation DAG.
+// It returns the first error of scc.ID, or ErrInvalidComponent if a vertex has a component id outside of
+// [0, scc.Count()), such as when scc was computed on a smaller digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewCondensation(digraph AdjacencyGraph, scc StronglyConnectedComponents) (*Digraph, error) {
+	count := scc.Count()
+	id := make([]int, digraph.V())
+	for v := range id {
+		var err error
+		if id[v], err = scc.ID(v); err != nil {
+			return nil, err
+		}
+		if id[v] < 0 || id[v] >= count {
+			return nil, ErrInvalidComponent
+		}
+	}
+	condensation, _ := NewDigraph(count)
+
+	// group the vertices by component, so that the edges leaving a component can be deduplicated together
+	members := make([][]int, count)
+	for v := 0; v < digraph.V(); v++ {
+		members[id[v]] = append(members[id[v]], v)
+	}
+	lastAdded := make([]int, count) // lastAdded[j] = 1 + last component i for which edge i->j was added
+	for i := 0; i < count; i++ {
+		for _, v := range members[i] {
+			adj, _ := digraph.Adj(v)
+			for w := range adj {
+				j := id[w]
+				if j != i && lastAdded[j] != i+1 {
+					lastAdded[j] = i + 1
+					condensation.AddEdge(i, j)
+				}
+			}
+		}
+	}
+	return condensation, nil
+}
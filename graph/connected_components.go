@@ -15,7 +15,7 @@ type ConnectedComponents struct {
 
 // NewConnectedComponents computes the connected components of the graph
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewConnectedComponents(graph *Graph) *ConnectedComponents {
+func NewConnectedComponents(graph AdjacencyGraph) *ConnectedComponents {
 	c := &ConnectedComponents{
 		marked: make([]bool, graph.V()),
 		id:     make([]int, graph.V()),
@@ -32,7 +32,7 @@ func NewConnectedComponents(graph *Graph) *ConnectedComponents {
 }
 
 // dfs (depth first search) from v
-func (c *ConnectedComponents) dfs(graph *Graph, v int) {
+func (c *ConnectedComponents) dfs(graph AdjacencyGraph, v int) {
 	c.marked[v] = true
 	c.id[v] = c.count
 	c.size[c.count]++
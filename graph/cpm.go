@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// CPM (critical path method) represents a data type for solving the parallel precedence-constrained job
+// scheduling problem: given a set of jobs with durations and precedence constraints, schedule the jobs
+// (by finding a start time for each) so as to achieve the minimum completion time, while respecting the constraints.
+// This implementation reduces the problem to the longest paths problem in an edge-weighted DAG of 2n + 2 vertices,
+// where n is the number of jobs, and solves it with AcyclicLP.
+// It uses O(n + m) extra space, where n is the number of jobs and m is the number of precedence constraints.
+type CPM struct {
+	n  int        // number of jobs
+	lp *AcyclicLP // longest paths from the source vertex (2n)
+}
+
+// NewCPM computes a schedule for the jobs, where durations[i] is the duration of job i and successors[i] lists
+// the jobs that must not start before job i finishes, ErrNotDAG if the precedence constraints have a cycle.
+// The complexity is O(n + m), where n is the number of jobs and m is the number of precedence constraints.
+func NewCPM(durations []float64, successors [][]int) (*CPM, error) {
+	n := len(durations)
+	if len(successors) > n {
+		return nil, ErrInvalidJobIndex
+	}
+
+	// source and sink
+	source := 2 * n
+	sink := 2*n + 1
+
+	// build network
+	digraph, _ := NewEdgeWeightedDigraph(2*n + 2)
+	for i := 0; i < n; i++ {
+		if math.IsNaN(durations[i]) || durations[i] < 0 {
+			return nil, ErrInvalidDuration
+		}
+		start, _ := NewDirectedEdge(source, i, 0)
+		work, _ := NewDirectedEdge(i, i+n, durations[i])
+		finish, _ := NewDirectedEdge(i+n, sink, 0)
+		digraph.AddEdge(start)
+		digraph.AddEdge(work)
+		digraph.AddEdge(finish)
+	}
+	for i, jobs := range successors {
+		for _, j := range jobs {
+			if j < 0 || j >= n {
+				return nil, ErrInvalidJobIndex
+			}
+			precedence, _ := NewDirectedEdge(i+n, j, 0)
+			digraph.AddEdge(precedence)
+		}
+	}
+
+	// compute longest path
+	lp, err := NewAcyclicLP(digraph, source)
+	if err != nil {
+		return nil, err
+	}
+	return &CPM{
+		n:  n,
+		lp: lp,
+	}, nil
+}
+
+var ErrInvalidJobIndex = errors.New("invalid job index")
+var ErrInvalidDuration = errors.New("duration must be a non-negative number")
+
+// StartTime returns the earliest start time of job i.
+// The complexity is O(1).
+func (c *CPM) StartTime(i int) (float64, error) {
+	if err := c.validateJob(i); err != nil {
+		return -1, err
+	}
+	return c.lp.DistTo(i)
+}
+
+// FinishTime returns the earliest finish time of job i.
+// The complexity is O(1).
+func (c *CPM) FinishTime(i int) (float64, error) {
+	if err := c.validateJob(i); err != nil {
+		return -1, err
+	}
+	return c.lp.DistTo(i + c.n)
+}
+
+// Makespan returns the minimum time in which all the jobs can be completed.
+// The complexity is O(1).
+func (c *CPM) Makespan() float64 {
+	if c.n == 0 {
+		return 0
+	}
+	makespan, _ := c.lp.DistTo(2*c.n + 1)
+	return makespan
+}
+
+// CriticalPath returns an iterator that iterates over the jobs of a critical path, a longest chain of jobs
+// whose durations sum to the makespan.
+// The complexity is O(n), where n is the number of jobs.
+func (c *CPM) CriticalPath() iter.Seq[int] {
+	jobs := fundamental.NewQueue[int]()
+	path, _ := c.lp.PathTo(2*c.n + 1)
+	for e := range path {
+		if e.From() < c.n && e.To() == e.From()+c.n {
+			jobs.Enqueue(e.From())
+		}
+	}
+	return jobs.Iterator()
+}
+
+func (c *CPM) validateJob(i int) error {
+	if i < 0 || i >= c.n {
+		return ErrInvalidJobIndex
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// csr is a compressed sparse row (CSR) representation of adjacency lists: the adjacency list of vertex v is
+// targets[offsets[v]:offsets[v+1]], sorted in ascending order.
+// It is shared by CSRGraph and CSRDigraph.
+type csr struct {
+	e       int   // number of edges
+	offsets []int // offsets[v] = start of the adjacency list of v in targets, offsets[V] = len(targets)
+	targets []int // concatenated adjacency lists
+}
+
+// newCSRFromAdj builds a csr with the adjacency lists of graph, without the number of edges
+func newCSRFromAdj(graph AdjacencyGraph) csr {
+	// size the targets exactly, so that no capacity is wasted, by counting the adjacency lists rather than trusting
+	// the degrees reported by a graph defined outside the package
+	c := csr{offsets: make([]int, graph.V()+1)}
+	for v := 0; v < graph.V(); v++ {
+		c.offsets[v+1] = c.offsets[v] + adjacencySize(graph, v)
+	}
+	c.targets = make([]int, c.offsets[graph.V()])
+	for v := 0; v < graph.V(); v++ {
+		adj, _ := graph.Adj(v)
+		i := c.offsets[v]
+		for w := range adj {
+			c.targets[i] = w
+			i++
+		}
+		slices.Sort(c.targets[c.offsets[v]:c.offsets[v+1]])
+	}
+	return c
+}
+
+// newCSRFromEdges builds a csr with v vertices from edges, adding each edge v-w to the adjacency list of w as well
+// if undirected is true
+func newCSRFromEdges(v int, edges iter.Seq2[int, int], undirected bool) (csr, error) {
+	if v < 0 {
+		return csr{}, ErrInvalidVertices
+	}
+	var from, to []int
+	for x, y := range edges {
+		if x < 0 || x >= v || y < 0 || y >= v {
+			return csr{}, ErrInvalidVertexIndex
+		}
+		from = append(from, x)
+		to = append(to, y)
+	}
+
+	// count the size of each adjacency list, then place each edge at the next free slot of its list
+	c := csr{e: len(from), offsets: make([]int, v+1)}
+	for i := range from {
+		c.offsets[from[i]+1]++
+		if undirected {
+			c.offsets[to[i]+1]++
+		}
+	}
+	for i := 0; i < v; i++ {
+		c.offsets[i+1] += c.offsets[i]
+	}
+	c.targets = make([]int, c.offsets[v])
+	next := slices.Clone(c.offsets[:v])
+	for i := range from {
+		c.targets[next[from[i]]] = to[i]
+		next[from[i]]++
+		if undirected {
+			c.targets[next[to[i]]] = from[i]
+			next[to[i]]++
+		}
+	}
+	for i := 0; i < v; i++ {
+		slices.Sort(c.targets[c.offsets[i]:c.offsets[i+1]])
+	}
+	return c, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (c *csr) V() int {
+	return len(c.offsets) - 1
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (c *csr) E() int {
+	return c.e
+}
+
+func (c *csr) validateVertex(v int) error {
+	if v < 0 || v >= c.V() {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// Adj returns an iterator that iterates over vertices adjacent to vertex v, in ascending order.
+// The complexity is O(1) (Though, iterating over the vertices returned by Adj(v) takes time proportional to the
+// degree of the vertex v).
+func (c *csr) Adj(v int) (iter.Seq[int], error) {
+	if err := c.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return slices.Values(c.targets[c.offsets[v]:c.offsets[v+1]]), nil
+}
+
+// size returns the size of the adjacency list of v
+func (c *csr) size(v int) int {
+	return c.offsets[v+1] - c.offsets[v]
+}
+
+// HasEdge returns true if there is an edge v-w (or v->w).
+// The complexity is O(log(deg(v))).
+func (c *csr) HasEdge(v, w int) (bool, error) {
+	if err := c.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := c.validateVertex(w); err != nil {
+		return false, err
+	}
+	_, found := slices.BinarySearch(c.targets[c.offsets[v]:c.offsets[v+1]], w)
+	return found, nil
+}
@@ -0,0 +1,75 @@
+package graph
+
+import "iter"
+
+// CSRDigraph represents an immutable directed graph of vertices named 0 through v – 1. This implementation uses a
+// compressed sparse row (CSR) representation: all the adjacency lists are stored, sorted, in a single array,
+// indexed by a vertex-indexed array of offsets. It is much more compact and cache-friendly than the adjacency lists
+// of Digraph, and can be used by all the digraph algorithms.
+// Parallel edges and self-loops are permitted.
+// It uses O(V + E) space, where V is the number of vertices and E is the number of edges.
+type CSRDigraph struct {
+	csr
+	inDegree []int // inDegree[v] = in-degree of vertex v
+}
+
+// NewCSRDigraph initializes a CSRDigraph with the same vertices and edges as digraph.
+// The complexity is O(V + E*log(E)), where V is the number of vertices and E is the number of edges.
+func NewCSRDigraph(digraph AdjacencyGraph) *CSRDigraph {
+	c := newCSRFromAdj(digraph)
+	c.e = len(c.targets)
+	return newCSRDigraph(c)
+}
+
+// NewCSRDigraphFromEdges initializes a CSRDigraph with v vertices and the directed edges v->w yielded by edges.
+// The complexity is O(V + E*log(E)), where V is the number of vertices and E is the number of edges.
+func NewCSRDigraphFromEdges(v int, edges iter.Seq2[int, int]) (*CSRDigraph, error) {
+	c, err := newCSRFromEdges(v, edges, false)
+	if err != nil {
+		return nil, err
+	}
+	return newCSRDigraph(c), nil
+}
+
+// newCSRDigraph initializes a CSRDigraph from c and computes the in-degrees
+func newCSRDigraph(c csr) *CSRDigraph {
+	inDegree := make([]int, c.V())
+	for _, w := range c.targets {
+		inDegree[w]++
+	}
+	return &CSRDigraph{csr: c, inDegree: inDegree}
+}
+
+// InDegree returns the in-degree of vertex v.
+// The complexity is O(1).
+func (digraph *CSRDigraph) InDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.inDegree[v], nil
+}
+
+// OutDegree returns the out-degree of vertex v.
+// The complexity is O(1).
+func (digraph *CSRDigraph) OutDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.size(v), nil
+}
+
+// Reverse returns the reverse of the digraph.
+// The complexity is O(V + E*log(E)), where V is the number of vertices and E is the number of edges.
+func (digraph *CSRDigraph) Reverse() *CSRDigraph {
+	edges := func(yield func(int, int) bool) {
+		for v := 0; v < digraph.V(); v++ {
+			for _, w := range digraph.targets[digraph.offsets[v]:digraph.offsets[v+1]] {
+				if !yield(w, v) {
+					return
+				}
+			}
+		}
+	}
+	c, _ := newCSRFromEdges(digraph.V(), edges, false)
+	return newCSRDigraph(c)
+}
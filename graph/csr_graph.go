@@ -0,0 +1,42 @@
+package graph
+
+import "iter"
+
+// CSRGraph represents an immutable undirected graph of vertices named 0 through v – 1. This implementation uses a
+// compressed sparse row (CSR) representation: all the adjacency lists are stored, sorted, in a single array,
+// indexed by a vertex-indexed array of offsets. It is much more compact and cache-friendly than the adjacency lists
+// of Graph, and can be used by all the graph algorithms.
+// Parallel edges and self-loops are permitted. By convention, a self-loop v-v appears in the adjacency list of v twice
+// and contributes two to the degree of v.
+// It uses O(V + E) space, where V is the number of vertices and E is the number of edges.
+type CSRGraph struct {
+	csr
+}
+
+// NewCSRGraph initializes a CSRGraph with the same vertices and edges as graph.
+// The complexity is O(V + E*log(E)), where V is the number of vertices and E is the number of edges.
+func NewCSRGraph(graph AdjacencyGraph) *CSRGraph {
+	c := newCSRFromAdj(graph)
+	// every edge (including self-loops) appears twice in the adjacency lists
+	c.e = len(c.targets) / 2
+	return &CSRGraph{csr: c}
+}
+
+// NewCSRGraphFromEdges initializes a CSRGraph with v vertices and the undirected edges v-w yielded by edges.
+// The complexity is O(V + E*log(E)), where V is the number of vertices and E is the number of edges.
+func NewCSRGraphFromEdges(v int, edges iter.Seq2[int, int]) (*CSRGraph, error) {
+	c, err := newCSRFromEdges(v, edges, true)
+	if err != nil {
+		return nil, err
+	}
+	return &CSRGraph{csr: c}, nil
+}
+
+// Degree returns the degree of vertex v.
+// The complexity is O(1).
+func (graph *CSRGraph) Degree(v int) (int, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return graph.size(v), nil
+}
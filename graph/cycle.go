@@ -17,7 +17,7 @@ type Cycle struct {
 // NewCycle determines whether the undirected graph has a cycle and, if so, finds such a cycle.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges. The depth-first search
 // part takes only O(V) time; however, checking for self-loops and parallel edges takes O(V + E) time in the worst case.
-func NewCycle(graph *Graph) *Cycle {
+func NewCycle(graph AdjacencyGraph) *Cycle {
 	c := &Cycle{
 		marked: make([]bool, graph.V()),
 		edgeTo: make([]int, graph.V()),
@@ -44,7 +44,7 @@ func NewCycle(graph *Graph) *Cycle {
 
 // hasParallelEdges returns true if the graph have two parallel edges
 // side effect: fill cycle to be two parallel edges
-func (c *Cycle) hasParallelEdges(graph *Graph) bool {
+func (c *Cycle) hasParallelEdges(graph AdjacencyGraph) bool {
 	for v := 0; v < graph.V(); v++ {
 
 		// check for parallel edges incident to v
@@ -70,7 +70,7 @@ func (c *Cycle) hasParallelEdges(graph *Graph) bool {
 
 // hasSelfLoop returns true if the graph have a self loop
 // side effect: fill cycle to be self loop
-func (c *Cycle) hasSelfLoop(graph *Graph) bool {
+func (c *Cycle) hasSelfLoop(graph AdjacencyGraph) bool {
 	for v := 0; v < graph.V(); v++ {
 		adj, _ := graph.Adj(v)
 		for w := range adj {
@@ -85,7 +85,7 @@ func (c *Cycle) hasSelfLoop(graph *Graph) bool {
 }
 
 // dfs (depth first search)
-func (c *Cycle) dfs(graph *Graph, v int, parent int) {
+func (c *Cycle) dfs(graph AdjacencyGraph, v int, parent int) {
 	c.marked[v] = true
 	adj, _ := graph.Adj(v)
 	for w := range adj {
@@ -17,7 +17,7 @@ type DepthFirstOrder struct {
 
 // NewDepthFirstOrder determines a depth-first order for the digraph.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewDepthFirstOrder(digraph *Digraph) *DepthFirstOrder {
+func NewDepthFirstOrder(digraph AdjacencyGraph) *DepthFirstOrder {
 	d := &DepthFirstOrder{
 		marked:      make([]bool, digraph.V()),
 		pre:         fundamental.NewQueue[int](),
@@ -32,8 +32,25 @@ func NewDepthFirstOrder(digraph *Digraph) *DepthFirstOrder {
 	return d
 }
 
+// NewEdgeWeightedDepthFirstOrder determines a depth-first order for the edge-weighted digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewEdgeWeightedDepthFirstOrder(digraph *EdgeWeightedDigraph) *DepthFirstOrder {
+	d := &DepthFirstOrder{
+		marked:      make([]bool, digraph.V()),
+		pre:         fundamental.NewQueue[int](),
+		post:        fundamental.NewQueue[int](),
+		reversePost: fundamental.NewStack[int](),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		if !d.marked[v] {
+			d.dfsEdgeWeighted(digraph, v)
+		}
+	}
+	return d
+}
+
 // dfs (depth first search) from v
-func (d *DepthFirstOrder) dfs(digraph *Digraph, v int) {
+func (d *DepthFirstOrder) dfs(digraph AdjacencyGraph, v int) {
 	d.pre.Enqueue(v)
 	d.marked[v] = true
 	adj, _ := digraph.Adj(v)
@@ -46,6 +63,20 @@ func (d *DepthFirstOrder) dfs(digraph *Digraph, v int) {
 	d.reversePost.Push(v)
 }
 
+// dfsEdgeWeighted (depth first search) from v in an edge-weighted digraph
+func (d *DepthFirstOrder) dfsEdgeWeighted(digraph *EdgeWeightedDigraph, v int) {
+	d.pre.Enqueue(v)
+	d.marked[v] = true
+	adj, _ := digraph.Adj(v)
+	for e := range adj {
+		if !d.marked[e.To()] {
+			d.dfsEdgeWeighted(digraph, e.To())
+		}
+	}
+	d.post.Enqueue(v)
+	d.reversePost.Push(v)
+}
+
 // Pre returns the vertices in preorder, as an iterable of vertices.
 // The complexity is O(1).
 func (d *DepthFirstOrder) Pre() iter.Seq[int] {
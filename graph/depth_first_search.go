@@ -9,8 +9,8 @@ type DepthFirstSearch struct {
 
 // NewDepthFirstSearch computes the vertices in graph that are connected to the source vertex (s).
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewDepthFirstSearch(graph UndirectedOrDirectedGraph, s int) (*DepthFirstSearch, error) {
-	if err := graph.validateVertex(s); err != nil {
+func NewDepthFirstSearch(graph AdjacencyGraph, s int) (*DepthFirstSearch, error) {
+	if err := validateVertex(graph, s); err != nil {
 		return nil, err
 	}
 	d := &DepthFirstSearch{
@@ -23,9 +23,9 @@ func NewDepthFirstSearch(graph UndirectedOrDirectedGraph, s int) (*DepthFirstSea
 
 // NewDepthFirstSearchMultiSource computes the vertices in graph that are connected to any of the source vertices (sources).
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewDepthFirstSearchMultiSource(graph UndirectedOrDirectedGraph, sources []int) (*DepthFirstSearch, error) {
+func NewDepthFirstSearchMultiSource(graph AdjacencyGraph, sources []int) (*DepthFirstSearch, error) {
 	for _, s := range sources {
-		if err := graph.validateVertex(s); err != nil {
+		if err := validateVertex(graph, s); err != nil {
 			return nil, err
 		}
 	}
@@ -42,7 +42,7 @@ func NewDepthFirstSearchMultiSource(graph UndirectedOrDirectedGraph, sources []i
 }
 
 // dfs (depth first search) from v
-func (d *DepthFirstSearch) dfs(graph UndirectedOrDirectedGraph, v int) {
+func (d *DepthFirstSearch) dfs(graph AdjacencyGraph, v int) {
 	d.count++
 	d.marked[v] = true
 	adj, _ := graph.Adj(v)
@@ -110,3 +110,65 @@ func (digraph *Digraph) Reverse() *Digraph {
 	}
 	return reverse
 }
+
+// RemoveEdge removes one copy of the directed edge v->w, ErrEdgeNotFound if there is no such edge.
+// The complexity is O(outdeg(v)).
+func (digraph *Digraph) RemoveEdge(v, w int) error {
+	if err := digraph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return err
+	}
+	if !digraph.adj[v].Remove(func(x int) bool { return x == w }) {
+		return ErrEdgeNotFound
+	}
+	digraph.inDegree[w]--
+	digraph.e--
+	return nil
+}
+
+// AddVertex adds an isolated vertex and returns its index (the previous number of vertices).
+// The complexity is O(1) (amortized).
+func (digraph *Digraph) AddVertex() int {
+	digraph.adj = append(digraph.adj, fundamental.NewBag[int]())
+	digraph.inDegree = append(digraph.inDegree, 0)
+	digraph.v++
+	return digraph.v - 1
+}
+
+// RemoveVertex removes vertex v and all its incident edges. The vertices after v are renumbered down by one
+// (vertex w > v becomes w - 1), so indices held outside the digraph must be updated (SymbolDigraph.RemoveVertex does
+// this for its names).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (digraph *Digraph) RemoveVertex(v int) error {
+	if err := digraph.validateVertex(v); err != nil {
+		return err
+	}
+	// after removing the outgoing edges of v, inDegree[v] no longer counts the self-loops v->v
+	for w := range digraph.adj[v].Iterator() {
+		digraph.inDegree[w]--
+	}
+	digraph.e -= digraph.adj[v].Size() + digraph.inDegree[v]
+	digraph.adj = removeVertex(digraph.adj, v)
+	digraph.inDegree = append(digraph.inDegree[:v], digraph.inDegree[v+1:]...)
+	digraph.v--
+	return nil
+}
+
+// HasEdge returns true if the digraph has the directed edge v->w.
+// The complexity is O(outdeg(v)).
+func (digraph *Digraph) HasEdge(v, w int) (bool, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return false, err
+	}
+	for x := range digraph.adj[v].Iterator() {
+		if x == w {
+			return true, nil
+		}
+	}
+	return false, nil
+}
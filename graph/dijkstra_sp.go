@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// DijkstraSP represents a data type for solving the single-source shortest paths problem in edge-weighted digraphs
+// where the edge weights are non-negative.
+// This implementation uses Dijkstra's algorithm with an indexed binary heap.
+// It uses O(V) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type DijkstraSP struct {
+	distTo []float64                        // distTo[v] = distance of shortest s->v path
+	edgeTo []*DirectedEdge                  // edgeTo[v] = last edge on shortest s->v path
+	pq     *fundamental.IndexMinPQ[float64] // priority queue of vertices
+}
+
+// NewDijkstraSP computes a shortest-paths tree from the source vertex (s) to every other vertex in the
+// edge-weighted digraph, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewDijkstraSP(digraph *EdgeWeightedDigraph, s int) (*DijkstraSP, error) {
+	return NewDijkstraSPMultiSource(digraph, []int{s})
+}
+
+// NewDijkstraSPMultiSource computes a shortest-paths tree from any one of the source vertices to every other vertex
+// in the edge-weighted digraph, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewDijkstraSPMultiSource(digraph *EdgeWeightedDigraph, sources []int) (*DijkstraSP, error) {
+	for e := range digraph.Edges() {
+		if e.Weight() < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+	for _, s := range sources {
+		if err := digraph.validateVertex(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return newDijkstraSP(digraph, sources, DirectedEdge.Weight), nil
+}
+
+// newDijkstraSP computes a shortest-paths tree from the (already validated) sources, where weight gives the
+// non-negative weight that is used for each edge.
+func newDijkstraSP(digraph *EdgeWeightedDigraph, sources []int, weight func(e DirectedEdge) float64) *DijkstraSP {
+	pq, _ := fundamental.NewIndexMinPQ[float64](digraph.V(), func(a, b float64) bool {
+		return a < b
+	})
+	d := &DijkstraSP{
+		distTo: make([]float64, digraph.V()),
+		edgeTo: make([]*DirectedEdge, digraph.V()),
+		pq:     pq,
+	}
+	for v := 0; v < digraph.V(); v++ {
+		d.distTo[v] = math.Inf(1)
+	}
+	for _, s := range sources {
+		if !d.pq.Contains(s) {
+			d.distTo[s] = 0
+			d.pq.Insert(s, d.distTo[s])
+		}
+	}
+
+	// relax vertices in order of distance from s
+	for !d.pq.IsEmpty() {
+		v, _ := d.pq.DelMin()
+		adj, _ := digraph.Adj(v)
+		for e := range adj {
+			d.relax(e, weight(e))
+		}
+	}
+	return d
+}
+
+var ErrNegativeWeight = errors.New("edge has negative weight")
+
+// relax edge e with the given weight and update pq if changed
+func (d *DijkstraSP) relax(e DirectedEdge, weight float64) {
+	v, w := e.From(), e.To()
+	if d.distTo[w] > d.distTo[v]+weight {
+		d.distTo[w] = d.distTo[v] + weight
+		d.edgeTo[w] = &e
+		if d.pq.Contains(w) {
+			d.pq.DecreaseKey(w, d.distTo[w])
+		} else {
+			d.pq.Insert(w, d.distTo[w])
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v.
+// The complexity is O(1).
+func (d *DijkstraSP) HasPathTo(v int) (bool, error) {
+	if err := d.validateVertex(v); err != nil {
+		return false, err
+	}
+	return d.distTo[v] < math.Inf(1), nil
+}
+
+// DistTo returns the length of a shortest path between the source vertex and vertex v, or
+// returns +Inf if there is no path.
+// The complexity is O(1).
+func (d *DijkstraSP) DistTo(v int) (float64, error) {
+	if err := d.validateVertex(v); err != nil {
+		return math.Inf(1), err
+	}
+	return d.distTo[v], nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a shortest path between the source vertex and vertex v.
+func (d *DijkstraSP) PathTo(v int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewStack[DirectedEdge]()
+	if hasPath, err := d.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+	for e := d.edgeTo[v]; e != nil; e = d.edgeTo[e.From()] {
+		path.Push(*e)
+	}
+	return path.Iterator(), nil
+}
+
+func (d *DijkstraSP) validateVertex(v int) error {
+	if v < 0 || v >= len(d.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// DijkstraUndirectedSP represents a data type for solving the single-source shortest paths problem in edge-weighted
+// graphs where the edge weights are non-negative.
+// This implementation uses Dijkstra's algorithm with an indexed binary heap.
+// It uses O(V) extra space (not including the edge-weighted graph), where V is the number of vertices.
+type DijkstraUndirectedSP struct {
+	distTo []float64                        // distTo[v] = distance of shortest s->v path
+	edgeTo []*Edge                          // edgeTo[v] = last edge on shortest s->v path
+	pq     *fundamental.IndexMinPQ[float64] // priority queue of vertices
+}
+
+// NewDijkstraUndirectedSP computes a shortest-paths tree from the source vertex (s) to every other vertex in the
+// edge-weighted graph, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewDijkstraUndirectedSP(graph *EdgeWeightedGraph, s int) (*DijkstraUndirectedSP, error) {
+	return NewDijkstraUndirectedSPMultiSource(graph, []int{s})
+}
+
+// NewDijkstraUndirectedSPMultiSource computes a shortest-paths tree from any one of the source vertices to every
+// other vertex in the edge-weighted graph, ErrNegativeWeight if an edge weight is negative.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewDijkstraUndirectedSPMultiSource(graph *EdgeWeightedGraph, sources []int) (*DijkstraUndirectedSP, error) {
+	for e := range graph.Edges() {
+		if e.Weight() < 0 {
+			return nil, ErrNegativeWeight
+		}
+	}
+	for _, s := range sources {
+		if err := graph.validateVertex(s); err != nil {
+			return nil, err
+		}
+	}
+
+	pq, _ := fundamental.NewIndexMinPQ[float64](graph.V(), func(a, b float64) bool {
+		return a < b
+	})
+	d := &DijkstraUndirectedSP{
+		distTo: make([]float64, graph.V()),
+		edgeTo: make([]*Edge, graph.V()),
+		pq:     pq,
+	}
+	for v := 0; v < graph.V(); v++ {
+		d.distTo[v] = math.Inf(1)
+	}
+	for _, s := range sources {
+		if !d.pq.Contains(s) {
+			d.distTo[s] = 0
+			d.pq.Insert(s, d.distTo[s])
+		}
+	}
+
+	// relax vertices in order of distance from s
+	for !d.pq.IsEmpty() {
+		v, _ := d.pq.DelMin()
+		adj, _ := graph.Adj(v)
+		for e := range adj {
+			d.relax(e, v)
+		}
+	}
+	return d, nil
+}
+
+// relax edge e leaving vertex v and update pq if changed
+func (d *DijkstraUndirectedSP) relax(e Edge, v int) {
+	w, _ := e.Other(v)
+	if d.distTo[w] > d.distTo[v]+e.Weight() {
+		d.distTo[w] = d.distTo[v] + e.Weight()
+		d.edgeTo[w] = &e
+		if d.pq.Contains(w) {
+			d.pq.DecreaseKey(w, d.distTo[w])
+		} else {
+			d.pq.Insert(w, d.distTo[w])
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and vertex v.
+// The complexity is O(1).
+func (d *DijkstraUndirectedSP) HasPathTo(v int) (bool, error) {
+	if err := d.validateVertex(v); err != nil {
+		return false, err
+	}
+	return d.distTo[v] < math.Inf(1), nil
+}
+
+// DistTo returns the length of a shortest path between the source vertex and vertex v, or
+// returns +Inf if there is no path.
+// The complexity is O(1).
+func (d *DijkstraUndirectedSP) DistTo(v int) (float64, error) {
+	if err := d.validateVertex(v); err != nil {
+		return math.Inf(1), err
+	}
+	return d.distTo[v], nil
+}
+
+// PathTo returns an iterator that iterates over the edges of a shortest path between the source vertex and vertex v.
+func (d *DijkstraUndirectedSP) PathTo(v int) (iter.Seq[Edge], error) {
+	path := fundamental.NewStack[Edge]()
+	if hasPath, err := d.HasPathTo(v); !hasPath {
+		return path.Iterator(), err
+	}
+	x := v
+	for e := d.edgeTo[v]; e != nil; e = d.edgeTo[x] {
+		path.Push(*e)
+		x, _ = e.Other(x)
+	}
+	return path.Iterator(), nil
+}
+
+func (d *DijkstraUndirectedSP) validateVertex(v int) error {
+	if v < 0 || v >= len(d.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
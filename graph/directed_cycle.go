@@ -17,7 +17,7 @@ type DirectedCycle struct {
 
 // NewDirectedCycle determines whether the digraph has a directed cycle and, if so, finds such a cycle.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewDirectedCycle(digraph *Digraph) *DirectedCycle {
+func NewDirectedCycle(digraph AdjacencyGraph) *DirectedCycle {
 	d := &DirectedCycle{
 		marked:  make([]bool, digraph.V()),
 		edgeTo:  make([]int, digraph.V()),
@@ -34,7 +34,7 @@ func NewDirectedCycle(digraph *Digraph) *DirectedCycle {
 }
 
 // dfs (depth first search)
-func (d *DirectedCycle) dfs(digraph *Digraph, v int) {
+func (d *DirectedCycle) dfs(digraph AdjacencyGraph, v int) {
 	d.onStack[v] = true
 	d.marked[v] = true
 	adj, _ := digraph.Adj(v)
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// DirectedEdge represents a weighted edge in an EdgeWeightedDigraph. Each edge consists of two integers
+// (naming the two vertices) and a real-value weight.
+// The data type provides methods for accessing the two endpoints of the directed edge and the weight.
+type DirectedEdge struct {
+	from   int     // tail vertex
+	to     int     // head vertex
+	weight float64 // edge weight
+}
+
+// NewDirectedEdge initializes a directed edge from vertex v to vertex w with the given weight.
+// The complexity is O(1).
+func NewDirectedEdge(v, w int, weight float64) (DirectedEdge, error) {
+	if v < 0 || w < 0 {
+		return DirectedEdge{}, ErrInvalidVertexIndex
+	}
+	if math.IsNaN(weight) {
+		return DirectedEdge{}, ErrInvalidWeight
+	}
+	return DirectedEdge{
+		from:   v,
+		to:     w,
+		weight: weight,
+	}, nil
+}
+
+// From returns the tail vertex of the directed edge.
+// The complexity is O(1).
+func (e DirectedEdge) From() int {
+	return e.from
+}
+
+// To returns the head vertex of the directed edge.
+// The complexity is O(1).
+func (e DirectedEdge) To() int {
+	return e.to
+}
+
+// Weight returns the weight of the directed edge.
+// The complexity is O(1).
+func (e DirectedEdge) Weight() float64 {
+	return e.weight
+}
+
+// String returns a string representation of the directed edge.
+// The complexity is O(1).
+func (e DirectedEdge) String() string {
+	return fmt.Sprintf("%d->%d %.5f", e.from, e.to, e.weight)
+}
@@ -17,14 +17,15 @@ type DirectedEulerian struct {
 
 // NewDirectedEulerian computes an Eulerian path or cycle in the specified digraph, if one exists.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewDirectedEulerian(digraph *Digraph) *DirectedEulerian {
+func NewDirectedEulerian(digraph AdjacencyGraph) *DirectedEulerian {
 	e := &DirectedEulerian{
 		status:      HasEulerianCycle,
 		pathOrCycle: fundamental.NewStack[int](),
 	}
 
 	// If there are no edges in the digraph, it is Eulerian (has cycle with length zero)
-	if digraph.E() == 0 {
+	edges := directedEdgeCount(digraph)
+	if edges == 0 {
 		return e
 	}
 
@@ -32,13 +33,13 @@ func NewDirectedEulerian(digraph *Digraph) *DirectedEulerian {
 	// if digraph has Eulerian cycle only non isolated vertex is enough
 	s := e.nonIsolatedVertex(digraph)
 	deficit := 0
+	inDegree := inDegrees(digraph)
 	for v := 0; v < digraph.V(); v++ {
-		outDegree, _ := digraph.OutDegree(v)
-		inDegree, _ := digraph.InDegree(v)
+		out := outDegree(digraph, v)
 		// digraph can't have an Eulerian cycle
-		if outDegree > inDegree {
+		if out > inDegree[v] {
 			e.status = HasEulerianPath
-			deficit += outDegree - inDegree
+			deficit += out - inDegree[v]
 			s = v
 			// digraph can't have an Eulerian path
 			if deficit > 1 {
@@ -73,7 +74,7 @@ func NewDirectedEulerian(digraph *Digraph) *DirectedEulerian {
 	}
 
 	// check if all edges are used
-	if e.pathOrCycle.Size() != digraph.E()+1 {
+	if e.pathOrCycle.Size() != edges+1 {
 		e.status = NotEulerian
 	}
 
@@ -81,10 +82,9 @@ func NewDirectedEulerian(digraph *Digraph) *DirectedEulerian {
 }
 
 // nonIsolatedVertex returns any non-isolated vertex, -1 if no such vertex.
-func (e *DirectedEulerian) nonIsolatedVertex(digraph *Digraph) int {
+func (e *DirectedEulerian) nonIsolatedVertex(digraph AdjacencyGraph) int {
 	for v := 0; v < digraph.V(); v++ {
-		outDegree, _ := digraph.OutDegree(v)
-		if outDegree > 0 {
+		if outDegree(digraph, v) > 0 {
 			return v
 		}
 	}
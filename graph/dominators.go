@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// Dominators represents a data type for computing the dominator tree of a flow graph: a digraph with a designated
+// entry vertex. A vertex a dominates a vertex b if every path from the entry to b goes through a. The immediate
+// dominator of b is the unique dominator of b (other than b) that is dominated by every other dominator of b.
+// The dominance frontier of a is the set of vertices b such that a dominates a predecessor of b but does not
+// strictly dominate b.
+// Vertices that are not reachable from the entry have no dominators and are not part of the dominator tree.
+// This implementation uses the Lengauer-Tarjan algorithm with path compression, on top of a depth-first search
+// preorder of the vertices reachable from the entry.
+// It uses O(V + E) extra space (not including the digraph), where V is the number of vertices and E is the number
+// of edges.
+type Dominators struct {
+	entry    int                       // entry vertex
+	idom     []int                     // idom[v] = immediate dominator of v, -1 for the entry and unreachable vertices
+	tree     *Digraph                  // dominator tree, with an edge idom[v]->v
+	pre      []int                     // pre[v] = preorder number of v in the dominator tree, -1 if unreachable
+	last     []int                     // last[v] = largest preorder number in the subtree of v in the dominator tree
+	frontier []*fundamental.Queue[int] // frontier[v] = dominance frontier of v
+}
+
+// NewDominators computes the dominator tree and the dominance frontiers of the digraph from the entry vertex.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewDominators(digraph AdjacencyGraph, entry int) (*Dominators, error) {
+	if err := validateVertex(digraph, entry); err != nil {
+		return nil, err
+	}
+	n := digraph.V()
+	d := &Dominators{
+		entry:    entry,
+		idom:     make([]int, n),
+		pre:      make([]int, n),
+		last:     make([]int, n),
+		frontier: make([]*fundamental.Queue[int], n),
+	}
+
+	// number the reachable vertices in depth-first preorder
+	lt := &lengauerTarjan{
+		semi:     make([]int, n),
+		vertex:   make([]int, 0, n),
+		parent:   make([]int, n),
+		ancestor: make([]int, n),
+		label:    make([]int, n),
+		preds:    make([][]int, n),
+		bucket:   make([][]int, n),
+	}
+	for v := 0; v < n; v++ {
+		lt.semi[v] = -1
+		lt.ancestor[v] = -1
+		lt.label[v] = v
+		d.idom[v] = -1
+	}
+	lt.dfs(digraph, entry)
+	for v := 0; v < n; v++ {
+		adj, _ := digraph.Adj(v)
+		for w := range adj {
+			if lt.semi[v] != -1 && lt.semi[w] != -1 {
+				lt.preds[w] = append(lt.preds[w], v)
+			}
+		}
+	}
+
+	// compute semidominators in reverse preorder and implicitly define immediate dominators
+	for i := len(lt.vertex) - 1; i > 0; i-- {
+		w := lt.vertex[i]
+		for _, v := range lt.preds[w] {
+			u := lt.eval(v)
+			if lt.semi[u] < lt.semi[w] {
+				lt.semi[w] = lt.semi[u]
+			}
+		}
+		lt.bucket[lt.vertex[lt.semi[w]]] = append(lt.bucket[lt.vertex[lt.semi[w]]], w)
+		lt.ancestor[w] = lt.parent[w]
+		p := lt.parent[w]
+		for _, v := range lt.bucket[p] {
+			u := lt.eval(v)
+			if lt.semi[u] < lt.semi[v] {
+				d.idom[v] = u
+			} else {
+				d.idom[v] = p
+			}
+		}
+		lt.bucket[p] = nil
+	}
+
+	// explicitly define immediate dominators in preorder
+	for i := 1; i < len(lt.vertex); i++ {
+		w := lt.vertex[i]
+		if d.idom[w] != lt.vertex[lt.semi[w]] {
+			d.idom[w] = d.idom[d.idom[w]]
+		}
+	}
+	d.idom[entry] = -1
+
+	d.tree, _ = NewDigraph(n)
+	for v := 0; v < n; v++ {
+		if d.idom[v] != -1 {
+			d.tree.AddEdge(d.idom[v], v)
+		}
+	}
+	d.numberTree()
+	d.computeFrontiers(digraph)
+	return d, nil
+}
+
+// lengauerTarjan a helper that holds the state of the Lengauer-Tarjan algorithm.
+type lengauerTarjan struct {
+	semi     []int   // semi[v] = preorder number of the semidominator of v, -1 if v is unreachable
+	vertex   []int   // vertex[i] = vertex whose preorder number is i
+	parent   []int   // parent[v] = parent of v in the depth-first search tree
+	ancestor []int   // ancestor[v] = ancestor of v in the forest of processed vertices, -1 for a root
+	label    []int   // label[v] = vertex with minimum semidominator on the compressed path to v
+	preds    [][]int // preds[v] = reachable predecessors of v
+	bucket   [][]int // bucket[v] = vertices whose semidominator is v
+}
+
+// dfs (depth first search) from v, numbering the vertices in preorder
+func (lt *lengauerTarjan) dfs(digraph AdjacencyGraph, v int) {
+	lt.semi[v] = len(lt.vertex)
+	lt.vertex = append(lt.vertex, v)
+	adj, _ := digraph.Adj(v)
+	for w := range adj {
+		if lt.semi[w] == -1 {
+			lt.parent[w] = v
+			lt.dfs(digraph, w)
+		}
+	}
+}
+
+// eval returns the vertex with minimum semidominator on the path from v to the root of its tree in the forest
+func (lt *lengauerTarjan) eval(v int) int {
+	if lt.ancestor[v] == -1 {
+		return v
+	}
+	lt.compress(v)
+	return lt.label[v]
+}
+
+// compress the path from v to the root of its tree in the forest
+func (lt *lengauerTarjan) compress(v int) {
+	a := lt.ancestor[v]
+	if lt.ancestor[a] == -1 {
+		return
+	}
+	lt.compress(a)
+	if lt.semi[lt.label[a]] < lt.semi[lt.label[v]] {
+		lt.label[v] = lt.label[a]
+	}
+	lt.ancestor[v] = lt.ancestor[a]
+}
+
+// numberTree numbers the dominator tree in preorder, so that dominance is an interval test
+func (d *Dominators) numberTree() {
+	for v := range d.pre {
+		d.pre[v] = -1
+		d.last[v] = -1
+	}
+	d.dfsTree(d.entry, 0)
+}
+
+// dfsTree (depth first search) from v in the dominator tree, where counter is the preorder number of v,
+// returns the next preorder number
+func (d *Dominators) dfsTree(v, counter int) int {
+	d.pre[v] = counter
+	counter++
+	adj, _ := d.tree.Adj(v)
+	for w := range adj {
+		counter = d.dfsTree(w, counter)
+	}
+	d.last[v] = counter - 1
+	return counter
+}
+
+// computeFrontiers computes the dominance frontiers by walking up the dominator tree from the predecessors of
+// each vertex to its immediate dominator
+func (d *Dominators) computeFrontiers(digraph AdjacencyGraph) {
+	preds := make([][]int, digraph.V())
+	for v := 0; v < digraph.V(); v++ {
+		d.frontier[v] = fundamental.NewQueue[int]()
+		if d.pre[v] == -1 {
+			continue
+		}
+		adj, _ := digraph.Adj(v)
+		for w := range adj {
+			preds[w] = append(preds[w], v)
+		}
+	}
+	lastAdded := make([]int, digraph.V()) // lastAdded[runner] = 1 + last vertex added to frontier[runner]
+	for b := 0; b < digraph.V(); b++ {
+		if d.pre[b] == -1 {
+			continue
+		}
+		for _, p := range preds[b] {
+			for runner := p; runner != -1 && runner != d.idom[b]; runner = d.idom[runner] {
+				if lastAdded[runner] != b+1 {
+					lastAdded[runner] = b + 1
+					d.frontier[runner].Enqueue(b)
+				}
+			}
+		}
+	}
+}
+
+// Entry returns the entry vertex.
+// The complexity is O(1).
+func (d *Dominators) Entry() int {
+	return d.entry
+}
+
+// IDom returns the immediate dominator of vertex v, -1 if v is the entry vertex or is not reachable from it.
+// The complexity is O(1).
+func (d *Dominators) IDom(v int) (int, error) {
+	if err := d.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return d.idom[v], nil
+}
+
+// Dominates returns true if vertex a dominates vertex b (every vertex reachable from the entry dominates itself).
+// The complexity is O(1).
+func (d *Dominators) Dominates(a, b int) (bool, error) {
+	if err := d.validateVertex(a); err != nil {
+		return false, err
+	}
+	if err := d.validateVertex(b); err != nil {
+		return false, err
+	}
+	if d.pre[a] == -1 || d.pre[b] == -1 {
+		return false, nil
+	}
+	return d.pre[a] <= d.pre[b] && d.pre[b] <= d.last[a], nil
+}
+
+// Tree returns the dominator tree as a digraph with an edge from the immediate dominator of each vertex to
+// the vertex.
+// The complexity is O(1).
+func (d *Dominators) Tree() *Digraph {
+	return d.tree
+}
+
+// Frontier returns an iterator that iterates over the dominance frontier of vertex v.
+// The complexity is O(1).
+func (d *Dominators) Frontier(v int) (iter.Seq[int], error) {
+	if err := d.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return d.frontier[v].Iterator(), nil
+}
+
+func (d *Dominators) validateVertex(v int) error {
+	if v < 0 || v >= len(d.idom) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
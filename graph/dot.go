@@ -0,0 +1,300 @@
+package graph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// The DOT writers render graphs in the Graphviz DOT language (https://graphviz.org/doc/info/lang.html), so that the
+// output can be piped into dot (e.g. dot -Tsvg) to visualize a graph and the results of the algorithms on it.
+// The results are added with DOTOption values, for example:
+//
+//	paths, _ := NewBreadthFirstPath(graph, s)
+//	path, _ := paths.PathTo(t)
+//	WriteGraphDOT(w, graph, WithHighlightedPath(path))
+//
+//	scc := NewKosarajuSCC(digraph)
+//	WriteDigraphDOT(w, digraph, WithClusters(scc.ID))
+
+// DOTOption configures the output of the DOT writers.
+type DOTOption func(*dotOptions)
+
+// dotOptions holds the configuration of a DOT writer
+type dotOptions struct {
+	name     string                    // name of the graph
+	paths    []iter.Seq[int]           // highlighted paths (or cycles)
+	edges    []dotEdge                 // highlighted weighted edges
+	clusters func(v int) (int, error)  // cluster id of each vertex
+	coloring func(v int) (bool, error) // two-coloring of the vertices
+}
+
+// dotEdge is an edge v-w (or v->w), with a weight if weighted is true
+type dotEdge struct {
+	v        int
+	w        int
+	weight   float64
+	weighted bool
+}
+
+// key returns the endpoints of the edge, in order for an undirected edge
+func (e dotEdge) key(directed bool) dotEdge {
+	if !directed && e.v > e.w {
+		e.v, e.w = e.w, e.v
+	}
+	return e
+}
+
+// WithGraphName sets the name of the graph in the output.
+func WithGraphName(name string) DOTOption {
+	return func(o *dotOptions) {
+		o.name = name
+	}
+}
+
+// WithHighlightedPath highlights the vertices of path and the edges between consecutive vertices, such as the
+// result of PathTo or Cycle.
+// With parallel edges, only as many edges as the path uses are highlighted.
+func WithHighlightedPath(path iter.Seq[int]) DOTOption {
+	return func(o *dotOptions) {
+		o.paths = append(o.paths, path)
+	}
+}
+
+// WithHighlightedEdges highlights edges of an edge-weighted graph and their endpoints, such as the edges of a
+// minimum spanning tree.
+func WithHighlightedEdges(edges iter.Seq[Edge]) DOTOption {
+	return func(o *dotOptions) {
+		for e := range edges {
+			w, _ := e.Other(e.Either())
+			o.edges = append(o.edges, dotEdge{v: e.Either(), w: w, weight: e.Weight(), weighted: true})
+		}
+	}
+}
+
+// WithHighlightedDirectedEdges highlights edges of an edge-weighted digraph and their endpoints, such as the result
+// of PathTo of a shortest paths algorithm or a negative cycle.
+func WithHighlightedDirectedEdges(edges iter.Seq[DirectedEdge]) DOTOption {
+	return func(o *dotOptions) {
+		for e := range edges {
+			o.edges = append(o.edges, dotEdge{v: e.From(), w: e.To(), weight: e.Weight(), weighted: true})
+		}
+	}
+}
+
+var ErrInvalidCluster = errors.New("cluster id must be non-negative, or -1 for no cluster")
+
+// WithClusters groups the vertices into clusters by the given id function, such as ConnectedComponents.ID or
+// KosarajuSCC.ID. An id of -1 leaves the vertex outside of every cluster, and the writer returns ErrInvalidCluster
+// for any other negative id.
+func WithClusters(id func(v int) (int, error)) DOTOption {
+	return func(o *dotOptions) {
+		o.clusters = id
+	}
+}
+
+// WithColoring fills the vertices with two colors by the given color function, such as Bipartite.Color.
+func WithColoring(color func(v int) (bool, error)) DOTOption {
+	return func(o *dotOptions) {
+		o.coloring = color
+	}
+}
+
+// WriteGraphDOT writes graph to w, in the DOT language.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteGraphDOT(w io.Writer, graph AdjacencyGraph, options ...DOTOption) error {
+	return writeDOT(w, false, graph.V(), unweightedDOTEdges(undirectedEdges(graph)), nil, options)
+}
+
+// WriteDigraphDOT writes digraph to w, in the DOT language.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteDigraphDOT(w io.Writer, digraph AdjacencyGraph, options ...DOTOption) error {
+	return writeDOT(w, true, digraph.V(), unweightedDOTEdges(directedEdges(digraph)), nil, options)
+}
+
+// WriteSymbolGraphDOT writes s to w, in the DOT language, with the vertices labeled by their names.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteSymbolGraphDOT(w io.Writer, s *SymbolGraph, options ...DOTOption) error {
+	return writeDOT(w, false, s.graph.V(), unweightedDOTEdges(undirectedEdges(s.graph)), s.keys, options)
+}
+
+// WriteSymbolDigraphDOT writes s to w, in the DOT language, with the vertices labeled by their names.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteSymbolDigraphDOT(w io.Writer, s *SymbolDigraph, options ...DOTOption) error {
+	return writeDOT(w, true, s.digraph.V(), unweightedDOTEdges(directedEdges(s.digraph)), s.keys, options)
+}
+
+// WriteEdgeWeightedGraphDOT writes graph to w, in the DOT language, with the edges labeled by their weights.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteEdgeWeightedGraphDOT(w io.Writer, graph *EdgeWeightedGraph, options ...DOTOption) error {
+	edges := func(yield func(dotEdge) bool) {
+		for e := range graph.Edges() {
+			w, _ := e.Other(e.Either())
+			if !yield(dotEdge{v: e.Either(), w: w, weight: e.Weight(), weighted: true}) {
+				return
+			}
+		}
+	}
+	return writeDOT(w, false, graph.V(), edges, nil, options)
+}
+
+// WriteEdgeWeightedDigraphDOT writes digraph to w, in the DOT language, with the edges labeled by their weights.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges (not including the
+// options).
+func WriteEdgeWeightedDigraphDOT(w io.Writer, digraph *EdgeWeightedDigraph, options ...DOTOption) error {
+	edges := func(yield func(dotEdge) bool) {
+		for e := range digraph.Edges() {
+			if !yield(dotEdge{v: e.From(), w: e.To(), weight: e.Weight(), weighted: true}) {
+				return
+			}
+		}
+	}
+	return writeDOT(w, true, digraph.V(), edges, nil, options)
+}
+
+// unweightedDOTEdges returns an iterator that iterates over the given edges as dotEdge values
+func unweightedDOTEdges(edges iter.Seq2[int, int]) iter.Seq[dotEdge] {
+	return func(yield func(dotEdge) bool) {
+		for v, w := range edges {
+			if !yield(dotEdge{v: v, w: w}) {
+				return
+			}
+		}
+	}
+}
+
+// writeDOT writes a graph with n vertices and the given edges to w, labeling the vertices with names (or their
+// indices if names is nil)
+func writeDOT(w io.Writer, directed bool, n int, edges iter.Seq[dotEdge], names []string, options []DOTOption) error {
+	o := &dotOptions{name: "G"}
+	for _, option := range options {
+		option(o)
+	}
+
+	// highlighted vertices and the number of highlighted copies of each edge, a path edge matches any weight
+	highlightedVertices := make([]bool, n)
+	highlightedEdges := make(map[dotEdge]int)
+	highlight := func(e dotEdge) {
+		if e.v >= 0 && e.v < n && e.w >= 0 && e.w < n {
+			highlightedVertices[e.v] = true
+			highlightedVertices[e.w] = true
+			highlightedEdges[e.key(directed)]++
+		}
+	}
+	for _, path := range o.paths {
+		prev := -1
+		for v := range path {
+			if v >= 0 && v < n {
+				highlightedVertices[v] = true
+			}
+			if prev != -1 {
+				highlight(dotEdge{v: prev, w: v})
+			}
+			prev = v
+		}
+	}
+	for _, e := range o.edges {
+		highlight(e)
+	}
+
+	bw := bufio.NewWriter(w)
+	keyword, edgeOp := "graph", "--"
+	if directed {
+		keyword, edgeOp = "digraph", "->"
+	}
+	fmt.Fprintf(bw, "%s %s {\n", keyword, dotQuote(o.name))
+
+	// vertices, grouped by cluster
+	clusters := map[int][]int{-1: nil}
+	ids := []int{-1}
+	for v := 0; v < n; v++ {
+		id := -1
+		if o.clusters != nil {
+			var err error
+			if id, err = o.clusters(v); err != nil {
+				return err
+			}
+			if id < -1 {
+				return ErrInvalidCluster
+			}
+		}
+		if _, ok := clusters[id]; !ok {
+			ids = append(ids, id)
+		}
+		clusters[id] = append(clusters[id], v)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		indent := "\t"
+		if id != -1 {
+			fmt.Fprintf(bw, "\tsubgraph %s {\n\t\tlabel=%s;\n", dotQuote(fmt.Sprintf("cluster_%d", id)), dotQuote(fmt.Sprint(id)))
+			indent = "\t\t"
+		}
+		for _, v := range clusters[id] {
+			var attributes []string
+			if names != nil {
+				attributes = append(attributes, "label="+dotQuote(names[v]))
+			}
+			if o.coloring != nil {
+				color, err := o.coloring(v)
+				if err != nil {
+					return err
+				}
+				fill := "lightblue"
+				if color {
+					fill = "lightpink"
+				}
+				attributes = append(attributes, "style=filled", "fillcolor="+fill)
+			}
+			if highlightedVertices[v] {
+				attributes = append(attributes, "color=red", "penwidth=2")
+			}
+			fmt.Fprintf(bw, "%s%d%s;\n", indent, v, dotAttributes(attributes))
+		}
+		if id != -1 {
+			fmt.Fprint(bw, "\t}\n")
+		}
+	}
+
+	// edges, an edge is highlighted if its weighted copy or its unweighted (path) copy is highlighted
+	for e := range edges {
+		var attributes []string
+		if e.weighted {
+			attributes = append(attributes, "label="+dotQuote(formatWeight(e.weight)))
+		}
+		unweighted := dotEdge{v: e.v, w: e.w}
+		for _, key := range []dotEdge{e.key(directed), unweighted.key(directed)} {
+			if highlightedEdges[key] > 0 {
+				highlightedEdges[key]--
+				attributes = append(attributes, "color=red", "penwidth=2")
+				break
+			}
+		}
+		fmt.Fprintf(bw, "\t%d %s %d%s;\n", e.v, edgeOp, e.w, dotAttributes(attributes))
+	}
+
+	fmt.Fprint(bw, "}\n")
+	return bw.Flush()
+}
+
+// dotAttributes formats an attribute list
+func dotAttributes(attributes []string) string {
+	if len(attributes) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(attributes, ", ") + "]"
+}
+
+// dotQuote returns s as a DOT quoted string
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
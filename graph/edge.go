@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Edge represents a weighted edge in an EdgeWeightedGraph. Each edge consists of two integers
+// (naming the two vertices) and a real-value weight.
+// The data type provides methods for accessing the two endpoints of the edge and the weight.
+// The natural order for this data type is by ascending order of weight.
+type Edge struct {
+	v      int     // one vertex
+	w      int     // the other vertex
+	weight float64 // edge weight
+}
+
+// NewEdge initializes an edge between vertices v and w of the given weight.
+// The complexity is O(1).
+func NewEdge(v, w int, weight float64) (Edge, error) {
+	if v < 0 || w < 0 {
+		return Edge{}, ErrInvalidVertexIndex
+	}
+	if math.IsNaN(weight) {
+		return Edge{}, ErrInvalidWeight
+	}
+	return Edge{
+		v:      v,
+		w:      w,
+		weight: weight,
+	}, nil
+}
+
+var ErrInvalidWeight = errors.New("weight is NaN")
+var ErrInvalidEndpoint = errors.New("vertex is not an endpoint of the edge")
+
+// Weight returns the weight of this edge.
+// The complexity is O(1).
+func (e Edge) Weight() float64 {
+	return e.weight
+}
+
+// Either returns either endpoint of this edge.
+// The complexity is O(1).
+func (e Edge) Either() int {
+	return e.v
+}
+
+// Other returns the endpoint of this edge that is different from the given vertex,
+// ErrInvalidEndpoint if vertex is not one of the endpoints of this edge.
+// The complexity is O(1).
+func (e Edge) Other(vertex int) (int, error) {
+	if vertex == e.v {
+		return e.w, nil
+	}
+	if vertex == e.w {
+		return e.v, nil
+	}
+	return -1, ErrInvalidEndpoint
+}
+
+// Compare compares two edges by weight:
+//
+//	if e.Weight() == that.Weight() then Compare returns 0
+//	if e.Weight() > that.Weight() then Compare returns 1
+//	if e.Weight() < that.Weight() then Compare returns -1
+//
+// The complexity is O(1).
+func (e Edge) Compare(that Edge) int {
+	if e.weight < that.weight {
+		return -1
+	}
+	if e.weight > that.weight {
+		return 1
+	}
+	return 0
+}
+
+// String returns a string representation of this edge.
+// The complexity is O(1).
+func (e Edge) String() string {
+	return fmt.Sprintf("%d-%d %.5f", e.v, e.w, e.weight)
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// EdgeWeightedDigraph represents an edge-weighted digraph of vertices named 0 through v – 1, where each directed edge
+// is of type DirectedEdge and has a real-valued weight. This implementation uses an adjacency-lists representation,
+// which is a vertex-indexed array of Bags.
+// Parallel edges and self-loops are permitted.
+// It uses O(V + E) space, where V is the number of vertices and E is the number of edges.
+type EdgeWeightedDigraph struct {
+	v        int                              // number of vertices
+	e        int                              // number of edges
+	adj      []*fundamental.Bag[DirectedEdge] // edges leaving each vertex
+	inDegree []int                            // inDegree[v] = in-degree of vertex v
+}
+
+// NewEdgeWeightedDigraph initializes an edge-weighted digraph with v number vertices
+// The complexity is O(V), where V is the number of vertices.
+func NewEdgeWeightedDigraph(v int) (*EdgeWeightedDigraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	adj := make([]*fundamental.Bag[DirectedEdge], v)
+	for i := 0; i < v; i++ {
+		adj[i] = fundamental.NewBag[DirectedEdge]()
+	}
+
+	return &EdgeWeightedDigraph{
+		v:        v,
+		e:        0,
+		adj:      adj,
+		inDegree: make([]int, v),
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (digraph *EdgeWeightedDigraph) V() int {
+	return digraph.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (digraph *EdgeWeightedDigraph) E() int {
+	return digraph.e
+}
+
+func (digraph *EdgeWeightedDigraph) validateVertex(v int) error {
+	if v < 0 || v >= digraph.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the directed edge e.
+// The complexity is O(1).
+func (digraph *EdgeWeightedDigraph) AddEdge(e DirectedEdge) error {
+	if err := digraph.validateVertex(e.From()); err != nil {
+		return err
+	}
+	if err := digraph.validateVertex(e.To()); err != nil {
+		return err
+	}
+	digraph.e++
+	digraph.adj[e.From()].Add(e)
+	digraph.inDegree[e.To()]++
+	return nil
+}
+
+// Adj returns an iterator that iterates over directed edges leaving vertex v.
+// The complexity is O(1) (Though, iterating over the edges returned by Adj(v) takes time proportional to the
+// out-degree of the vertex v).
+func (digraph *EdgeWeightedDigraph) Adj(v int) (iter.Seq[DirectedEdge], error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return digraph.adj[v].Iterator(), nil
+}
+
+// InDegree returns the in-degree of vertex v.
+// The complexity is O(1).
+func (digraph *EdgeWeightedDigraph) InDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.inDegree[v], nil
+}
+
+// OutDegree returns the out-degree of vertex v.
+// The complexity is O(1).
+func (digraph *EdgeWeightedDigraph) OutDegree(v int) (int, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return digraph.adj[v].Size(), nil
+}
+
+// HasEdge returns true if the digraph has a directed edge v->w (of any weight).
+// The complexity is O(outdeg(v)).
+func (digraph *EdgeWeightedDigraph) HasEdge(v, w int) (bool, error) {
+	if err := digraph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := digraph.validateVertex(w); err != nil {
+		return false, err
+	}
+	for e := range digraph.adj[v].Iterator() {
+		if e.To() == w {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Edges returns an iterator that iterates over all directed edges in the edge-weighted digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (digraph *EdgeWeightedDigraph) Edges() iter.Seq[DirectedEdge] {
+	return func(yield func(DirectedEdge) bool) {
+		for v := 0; v < digraph.v; v++ {
+			for e := range digraph.adj[v].Iterator() {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reverse returns the reverse of the edge-weighted digraph, each edge keeps its weight.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (digraph *EdgeWeightedDigraph) Reverse() *EdgeWeightedDigraph {
+	reverse, _ := NewEdgeWeightedDigraph(digraph.v)
+	for e := range digraph.Edges() {
+		r, _ := NewDirectedEdge(e.To(), e.From(), e.Weight())
+		reverse.AddEdge(r)
+	}
+	return reverse
+}
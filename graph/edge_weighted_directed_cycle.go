@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// EdgeWeightedDirectedCycle represents a data type for determining whether an edge-weighted digraph has a
+// directed cycle.
+// This implementation uses depth-first search (DFS).
+// It uses O(V) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type EdgeWeightedDirectedCycle struct {
+	marked  []bool                           // marked[v] = has vertex v been marked?
+	edgeTo  []DirectedEdge                   // edgeTo[v] = previous edge on path to v
+	onStack []bool                           // onStack[v] = is vertex on the stack?
+	cycle   *fundamental.Stack[DirectedEdge] // directed cycle
+}
+
+// NewEdgeWeightedDirectedCycle determines whether the edge-weighted digraph has a directed cycle and, if so,
+// finds such a cycle.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewEdgeWeightedDirectedCycle(digraph *EdgeWeightedDigraph) *EdgeWeightedDirectedCycle {
+	d := &EdgeWeightedDirectedCycle{
+		marked:  make([]bool, digraph.V()),
+		edgeTo:  make([]DirectedEdge, digraph.V()),
+		onStack: make([]bool, digraph.V()),
+		cycle:   fundamental.NewStack[DirectedEdge](),
+	}
+
+	for v := 0; v < digraph.V(); v++ {
+		if !d.marked[v] && d.cycle.IsEmpty() {
+			d.dfs(digraph, v)
+		}
+	}
+	return d
+}
+
+// dfs (depth first search)
+func (d *EdgeWeightedDirectedCycle) dfs(digraph *EdgeWeightedDigraph, v int) {
+	d.onStack[v] = true
+	d.marked[v] = true
+	adj, _ := digraph.Adj(v)
+	for e := range adj {
+		w := e.To()
+
+		// short circuit if cycle already found
+		if !d.cycle.IsEmpty() {
+			return
+		}
+
+		// found a new vertex, then recur, otherwise trace back directed cycle
+		if !d.marked[w] {
+			d.edgeTo[w] = e
+			d.dfs(digraph, w)
+		} else if d.onStack[w] {
+			f := e
+			for f.From() != w {
+				d.cycle.Push(f)
+				f = d.edgeTo[f.From()]
+			}
+			d.cycle.Push(f)
+			return
+		}
+	}
+	d.onStack[v] = false
+}
+
+// HasCycle returns true if the edge-weighted digraph has a directed cycle.
+// The complexity is O(1).
+func (d *EdgeWeightedDirectedCycle) HasCycle() bool {
+	return !d.cycle.IsEmpty()
+}
+
+// Cycle returns an iterator that iterates over the edges of a directed cycle in the edge-weighted digraph.
+// The complexity is O(1).
+func (d *EdgeWeightedDirectedCycle) Cycle() iter.Seq[DirectedEdge] {
+	return d.cycle.Iterator()
+}
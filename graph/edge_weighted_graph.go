@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// EdgeWeightedGraph represents an edge-weighted graph of vertices named 0 through v – 1, where each undirected edge
+// is of type Edge and has a real-valued weight. This implementation uses an adjacency-lists representation, which
+// is a vertex-indexed array of Bags.
+// Parallel edges and self-loops are permitted. By convention, a self-loop v-v appears in the adjacency list of v twice
+// and contributes two to the degree of v.
+// It uses O(V + E) space, where V is the number of vertices and E is the number of edges.
+type EdgeWeightedGraph struct {
+	v   int                      // number of vertices
+	e   int                      // number of edges
+	adj []*fundamental.Bag[Edge] // incident edges
+}
+
+// NewEdgeWeightedGraph initializes an edge-weighted graph with v number vertices
+// The complexity is O(V), where V is the number of vertices.
+func NewEdgeWeightedGraph(v int) (*EdgeWeightedGraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	adj := make([]*fundamental.Bag[Edge], v)
+	for i := 0; i < v; i++ {
+		adj[i] = fundamental.NewBag[Edge]()
+	}
+
+	return &EdgeWeightedGraph{
+		v:   v,
+		e:   0,
+		adj: adj,
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (graph *EdgeWeightedGraph) V() int {
+	return graph.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (graph *EdgeWeightedGraph) E() int {
+	return graph.e
+}
+
+func (graph *EdgeWeightedGraph) validateVertex(v int) error {
+	if v < 0 || v >= graph.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the undirected edge e.
+// The complexity is O(1).
+func (graph *EdgeWeightedGraph) AddEdge(e Edge) error {
+	v := e.Either()
+	w, _ := e.Other(v)
+	if err := graph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return err
+	}
+	graph.e++
+	graph.adj[v].Add(e)
+	graph.adj[w].Add(e)
+	return nil
+}
+
+// Adj returns an iterator that iterates over edges incident to vertex v.
+// The complexity is O(1) (Though, iterating over the edges returned by Adj(v) takes time proportional to the
+// degree of the vertex v).
+func (graph *EdgeWeightedGraph) Adj(v int) (iter.Seq[Edge], error) {
+	if err := graph.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return graph.adj[v].Iterator(), nil
+}
+
+// Degree returns the degree of vertex v.
+// The complexity is O(1).
+func (graph *EdgeWeightedGraph) Degree(v int) (int, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return graph.adj[v].Size(), nil
+}
+
+// HasEdge returns true if the graph has an undirected edge v-w (of any weight).
+// The complexity is O(min(deg(v), deg(w))).
+func (graph *EdgeWeightedGraph) HasEdge(v, w int) (bool, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return false, err
+	}
+	if graph.adj[v].Size() > graph.adj[w].Size() {
+		v, w = w, v
+	}
+	for e := range graph.adj[v].Iterator() {
+		if x, _ := e.Other(v); x == w {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Edges returns an iterator that iterates over all edges in the edge-weighted graph.
+// Each edge is returned once, including self-loops.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (graph *EdgeWeightedGraph) Edges() iter.Seq[Edge] {
+	edges := fundamental.NewBag[Edge]()
+	for v := 0; v < graph.v; v++ {
+		selfLoops := 0
+		for e := range graph.adj[v].Iterator() {
+			w, _ := e.Other(v)
+			if w > v {
+				edges.Add(e)
+			} else if w == v {
+				// add only one copy of each self loop (self loops will be consecutive)
+				if selfLoops%2 == 0 {
+					edges.Add(e)
+				}
+				selfLoops++
+			}
+		}
+	}
+	return edges.Iterator()
+}
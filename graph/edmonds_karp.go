@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"math"
+)
+
+// EdmondsKarp represents a data type for computing a maximum st-flow and a minimum st-cut in a flow network.
+// This implementation uses the Ford-Fulkerson algorithm with the shortest augmenting path heuristic (Edmonds-Karp),
+// where each augmenting path is found by breadth-first search in the residual network.
+// The flow is stored in the edges of the flow network, so FlowEdge.Flow returns the flow of each edge in a maximum
+// flow, starting from the current flow of the edges (which is zero for a new network).
+// It uses O(V) extra space (not including the network), where V is the number of vertices.
+type EdmondsKarp struct {
+	marked []bool      // marked[v] = true if s->v path in residual network
+	edgeTo []*FlowEdge // edgeTo[v] = last edge on shortest residual s->v path
+	value  float64     // current value of max flow
+}
+
+// NewEdmondsKarp computes a maximum flow and minimum cut in the flow network from vertex s to vertex t,
+// ErrSameSourceAndSink if s == t.
+// The complexity is O(V*E²), where V is the number of vertices and E is the number of edges.
+func NewEdmondsKarp(network *FlowNetwork, s, t int) (*EdmondsKarp, error) {
+	if err := network.validateVertex(s); err != nil {
+		return nil, err
+	}
+	if err := network.validateVertex(t); err != nil {
+		return nil, err
+	}
+	if s == t {
+		return nil, ErrSameSourceAndSink
+	}
+
+	f := &EdmondsKarp{
+		marked: make([]bool, network.V()),
+		edgeTo: make([]*FlowEdge, network.V()),
+		value:  network.excess(t),
+	}
+
+	// while there exists an augmenting path, use it
+	for f.hasAugmentingPath(network, s, t) {
+		// compute bottleneck capacity
+		bottle := math.Inf(1)
+		for v := t; v != s; v, _ = f.edgeTo[v].Other(v) {
+			residual, _ := f.edgeTo[v].ResidualCapacityTo(v)
+			bottle = math.Min(bottle, residual)
+		}
+
+		// augment flow
+		for v := t; v != s; v, _ = f.edgeTo[v].Other(v) {
+			f.edgeTo[v].AddResidualFlowTo(v, bottle)
+		}
+		f.value += bottle
+	}
+	return f, nil
+}
+
+var ErrSameSourceAndSink = errors.New("source and sink must be distinct")
+
+// hasAugmentingPath (breadth first search) returns true if there is an augmenting path in the residual network,
+// side effect: fill edgeTo with the path and marked with the vertices reachable from s
+func (f *EdmondsKarp) hasAugmentingPath(network *FlowNetwork, s, t int) bool {
+	for v := 0; v < network.V(); v++ {
+		f.marked[v] = false
+		f.edgeTo[v] = nil
+	}
+
+	q := fundamental.NewQueue[int]()
+	q.Enqueue(s)
+	f.marked[s] = true
+	for !q.IsEmpty() && !f.marked[t] {
+		v, _ := q.Dequeue()
+		adj, _ := network.Adj(v)
+		for e := range adj {
+			w, _ := e.Other(v)
+			// if residual capacity from v to w
+			if residual, _ := e.ResidualCapacityTo(w); residual > 0 && !f.marked[w] {
+				f.edgeTo[w] = e
+				f.marked[w] = true
+				q.Enqueue(w)
+			}
+		}
+	}
+	return f.marked[t]
+}
+
+// Value returns the value of the maximum flow.
+// The complexity is O(1).
+func (f *EdmondsKarp) Value() float64 {
+	return f.value
+}
+
+// InCut returns true if vertex v is on the s side of the minimum st-cut.
+// The complexity is O(1).
+func (f *EdmondsKarp) InCut(v int) (bool, error) {
+	if err := f.validateVertex(v); err != nil {
+		return false, err
+	}
+	return f.marked[v], nil
+}
+
+func (f *EdmondsKarp) validateVertex(v int) error {
+	if v < 0 || v >= len(f.marked) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// EdmondsMatching represents a data type for computing a maximum (cardinality) matching in an undirected graph,
+// which need not be bipartite. A matching in a graph is a subset of its edges with no common vertices.
+// This implementation uses Edmonds' blossom algorithm: it searches for augmenting paths with breadth-first search
+// and contracts each odd cycle (blossom) it meets into its base vertex.
+// Self-loops are ignored, since they can never be in a matching, and parallel edges are treated as a single edge.
+// It uses O(V) extra space (not including the graph), where V is the number of vertices.
+type EdmondsMatching struct {
+	mate        []int                   // mate[v] = w if v-w is an edge in the current matching, -1 if v is unmatched
+	cardinality int                     // number of edges in the current matching
+	parent      []int                   // parent[v] = previous vertex on alternating path to v in the current search
+	base        []int                   // base[v] = base vertex of the blossom containing v
+	used        []bool                  // used[v] = is v an outer vertex in the current search?
+	blossom     []bool                  // blossom[v] = is v the base of a vertex on the current blossom?
+	queue       *fundamental.Queue[int] // outer vertices to scan in the current search
+}
+
+// NewEdmondsMatching determines a maximum matching in the graph.
+// The complexity is O(V³), where V is the number of vertices.
+func NewEdmondsMatching(graph AdjacencyGraph) *EdmondsMatching {
+	m := &EdmondsMatching{
+		mate:        make([]int, graph.V()),
+		cardinality: 0,
+		parent:      make([]int, graph.V()),
+		base:        make([]int, graph.V()),
+		used:        make([]bool, graph.V()),
+		blossom:     make([]bool, graph.V()),
+	}
+	for v := 0; v < graph.V(); v++ {
+		m.mate[v] = -1
+	}
+
+	for v := 0; v < graph.V(); v++ {
+		if m.mate[v] != -1 {
+			continue
+		}
+		// augment the matching along the path to u (if any)
+		u := m.findAugmentingPath(graph, v)
+		if u != -1 {
+			m.cardinality++
+		}
+		for u != -1 {
+			pu := m.parent[u]
+			ppu := m.mate[pu]
+			m.mate[u] = pu
+			m.mate[pu] = u
+			u = ppu
+		}
+	}
+	return m
+}
+
+// findAugmentingPath (breadth first search) returns the free vertex at the end of an augmenting path from root,
+// -1 if there is no such path, side effect: fill parent with the path
+func (m *EdmondsMatching) findAugmentingPath(graph AdjacencyGraph, root int) int {
+	for v := 0; v < graph.V(); v++ {
+		m.used[v] = false
+		m.parent[v] = -1
+		m.base[v] = v
+	}
+	m.used[root] = true
+	m.queue = fundamental.NewQueue[int]()
+	m.queue.Enqueue(root)
+
+	for !m.queue.IsEmpty() {
+		v, _ := m.queue.Dequeue()
+		adj, _ := graph.Adj(v)
+		for w := range adj {
+			// skip self-loops, edges inside a blossom and the matched edge
+			if m.base[v] == m.base[w] || m.mate[v] == w {
+				continue
+			}
+			if w == root || (m.mate[w] != -1 && m.parent[m.mate[w]] != -1) {
+				// w is an outer vertex, v-w closes a blossom
+				m.contractBlossom(graph, v, w)
+			} else if m.parent[w] == -1 {
+				m.parent[w] = v
+				if m.mate[w] == -1 {
+					return w
+				}
+				m.used[m.mate[w]] = true
+				m.queue.Enqueue(m.mate[w])
+			}
+		}
+	}
+	return -1
+}
+
+// contractBlossom contracts the blossom closed by the edge v-w into its base
+func (m *EdmondsMatching) contractBlossom(graph AdjacencyGraph, v, w int) {
+	b := m.lowestCommonAncestor(v, w)
+	for i := 0; i < graph.V(); i++ {
+		m.blossom[i] = false
+	}
+	m.markPath(v, b, w)
+	m.markPath(w, b, v)
+	for i := 0; i < graph.V(); i++ {
+		if m.blossom[m.base[i]] {
+			m.base[i] = b
+			if !m.used[i] {
+				m.used[i] = true
+				m.queue.Enqueue(i)
+			}
+		}
+	}
+}
+
+// lowestCommonAncestor returns the base of the blossom closed by the edge v-w, the first common base on the
+// alternating paths from v and w to the root
+func (m *EdmondsMatching) lowestCommonAncestor(v, w int) int {
+	onPath := make([]bool, len(m.mate))
+	for {
+		v = m.base[v]
+		onPath[v] = true
+		if m.mate[v] == -1 {
+			break
+		}
+		v = m.parent[m.mate[v]]
+	}
+	for {
+		w = m.base[w]
+		if onPath[w] {
+			return w
+		}
+		w = m.parent[m.mate[w]]
+	}
+}
+
+// markPath marks the blossom vertices on the path from v to the base b and redirects their parents so that
+// the augmenting path can go around the blossom through child
+func (m *EdmondsMatching) markPath(v, b, child int) {
+	for m.base[v] != b {
+		m.blossom[m.base[v]] = true
+		m.blossom[m.base[m.mate[v]]] = true
+		m.parent[v] = child
+		child = m.mate[v]
+		v = m.parent[m.mate[v]]
+	}
+}
+
+// Mate returns the vertex to which vertex v is matched in the maximum matching, -1 if v is unmatched.
+// The complexity is O(1).
+func (m *EdmondsMatching) Mate(v int) (int, error) {
+	if err := m.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return m.mate[v], nil
+}
+
+// IsMatched returns true if vertex v is matched in the maximum matching.
+// The complexity is O(1).
+func (m *EdmondsMatching) IsMatched(v int) (bool, error) {
+	if err := m.validateVertex(v); err != nil {
+		return false, err
+	}
+	return m.mate[v] != -1, nil
+}
+
+// Size returns the number of edges in the maximum matching.
+// The complexity is O(1).
+func (m *EdmondsMatching) Size() int {
+	return m.cardinality
+}
+
+// IsPerfect returns true if the graph has a perfect matching (every vertex is matched).
+// The complexity is O(1).
+func (m *EdmondsMatching) IsPerfect() bool {
+	return 2*m.cardinality == len(m.mate)
+}
+
+// Edges returns an iterator that iterates over the edges v-w (v < w) of the maximum matching.
+// The complexity is O(1) (Though, iterating over the edges takes time proportional to V).
+func (m *EdmondsMatching) Edges() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for v := 0; v < len(m.mate); v++ {
+			if v < m.mate[v] && !yield(v, m.mate[v]) {
+				return
+			}
+		}
+	}
+}
+
+func (m *EdmondsMatching) validateVertex(v int) error {
+	if v < 0 || v >= len(m.mate) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
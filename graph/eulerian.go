@@ -50,14 +50,15 @@ func (e *eulerianEdge) otherEdge(vertex int) int {
 
 // NewEulerian computes an Eulerian path or cycle in the specified graph, if one exists.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewEulerian(graph *Graph) *Eulerian {
+func NewEulerian(graph AdjacencyGraph) *Eulerian {
 	e := &Eulerian{
 		status:      HasEulerianCycle,
 		pathOrCycle: fundamental.NewStack[int](),
 	}
 
 	// If there are no edges in the graph, it is Eulerian (has cycle with length zero)
-	if graph.E() == 0 {
+	edges := undirectedEdgeCount(graph)
+	if edges == 0 {
 		return e
 	}
 
@@ -66,9 +67,8 @@ func NewEulerian(graph *Graph) *Eulerian {
 	s := e.nonIsolatedVertex(graph)
 	oddDegreeVertices := 0
 	for v := 0; v < graph.V(); v++ {
-		degree, _ := graph.Degree(v)
 		// graph can't have an Eulerian cycle
-		if degree%2 != 0 {
+		if degree(graph, v)%2 != 0 {
 			e.status = HasEulerianPath
 			oddDegreeVertices++
 			s = v
@@ -126,7 +126,7 @@ func NewEulerian(graph *Graph) *Eulerian {
 	}
 
 	// check if all edges are used
-	if e.pathOrCycle.Size() != graph.E()+1 {
+	if e.pathOrCycle.Size() != edges+1 {
 		e.status = NotEulerian
 	}
 
@@ -134,10 +134,9 @@ func NewEulerian(graph *Graph) *Eulerian {
 }
 
 // nonIsolatedVertex returns any non-isolated vertex, -1 if no such vertex.
-func (e *Eulerian) nonIsolatedVertex(graph *Graph) int {
+func (e *Eulerian) nonIsolatedVertex(graph AdjacencyGraph) int {
 	for v := 0; v < graph.V(); v++ {
-		degree, _ := graph.Degree(v)
-		if degree > 0 {
+		if degree(graph, v) > 0 {
 			return v
 		}
 	}
@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// floatingPointEpsilon is used to round flows that are very close to zero or to the capacity
+const floatingPointEpsilon = 1e-10
+
+// FlowEdge represents a capacitated edge with a flow in a FlowNetwork. Each edge consists of two integers
+// (naming the two vertices), a real-valued capacity, and a real-valued flow.
+// The data type provides methods for accessing the two endpoints of the directed edge and the capacity.
+// It also provides methods for changing the amount of flow on the edge and determining the residual capacity
+// of the edge.
+type FlowEdge struct {
+	v        int     // from
+	w        int     // to
+	capacity float64 // capacity
+	flow     float64 // flow
+}
+
+// NewFlowEdge initializes an edge from vertex v to vertex w with the given capacity and zero flow,
+// ErrInvalidCapacity if the capacity is negative, NaN or infinite (use a capacity larger than the total capacity
+// of the other edges instead of an infinite one).
+// The complexity is O(1).
+func NewFlowEdge(v, w int, capacity float64) (*FlowEdge, error) {
+	if v < 0 || w < 0 {
+		return nil, ErrInvalidVertexIndex
+	}
+	if math.IsNaN(capacity) || math.IsInf(capacity, 0) || capacity < 0 {
+		return nil, ErrInvalidCapacity
+	}
+	return &FlowEdge{
+		v:        v,
+		w:        w,
+		capacity: capacity,
+		flow:     0,
+	}, nil
+}
+
+var ErrInvalidCapacity = errors.New("edge capacity must be a finite non-negative number")
+var ErrInvalidFlow = errors.New("flow is infeasible")
+
+// From returns the tail vertex of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) From() int {
+	return e.v
+}
+
+// To returns the head vertex of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) To() int {
+	return e.w
+}
+
+// Capacity returns the capacity of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) Capacity() float64 {
+	return e.capacity
+}
+
+// Flow returns the flow on the edge.
+// The complexity is O(1).
+func (e *FlowEdge) Flow() float64 {
+	return e.flow
+}
+
+// Other returns the endpoint of the edge that is different from the given vertex,
+// ErrInvalidEndpoint if vertex is not one of the endpoints of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) Other(vertex int) (int, error) {
+	if vertex == e.v {
+		return e.w, nil
+	}
+	if vertex == e.w {
+		return e.v, nil
+	}
+	return -1, ErrInvalidEndpoint
+}
+
+// ResidualCapacityTo returns the residual capacity of the edge in the direction to the given vertex:
+// the remaining capacity (capacity - flow) towards the head vertex, and the flow towards the tail vertex,
+// ErrInvalidEndpoint if vertex is not one of the endpoints of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) ResidualCapacityTo(vertex int) (float64, error) {
+	if vertex == e.v {
+		// backward edge
+		return e.flow, nil
+	}
+	if vertex == e.w {
+		// forward edge
+		return e.capacity - e.flow, nil
+	}
+	return 0, ErrInvalidEndpoint
+}
+
+// AddResidualFlowTo increases the flow on the edge in the direction to the given vertex: if vertex is the head
+// vertex, this increases the flow on the edge by delta, if vertex is the tail vertex, this decreases the flow on
+// the edge by delta. It returns ErrInvalidEndpoint if vertex is not one of the endpoints of the edge and
+// ErrInvalidFlow if delta is negative or larger than the residual capacity.
+// The complexity is O(1).
+func (e *FlowEdge) AddResidualFlowTo(vertex int, delta float64) error {
+	if vertex != e.v && vertex != e.w {
+		return ErrInvalidEndpoint
+	}
+	if math.IsNaN(delta) || delta < 0 {
+		return ErrInvalidFlow
+	}
+	residual, _ := e.ResidualCapacityTo(vertex)
+	if delta > residual+floatingPointEpsilon {
+		return ErrInvalidFlow
+	}
+
+	if vertex == e.v {
+		e.flow -= delta
+	} else {
+		e.flow += delta
+	}
+
+	// round flow to 0 or capacity if within floating-point precision
+	if math.Abs(e.flow) <= floatingPointEpsilon {
+		e.flow = 0
+	}
+	if math.Abs(e.flow-e.capacity) <= floatingPointEpsilon {
+		e.flow = e.capacity
+	}
+	return nil
+}
+
+// String returns a string representation of the edge.
+// The complexity is O(1).
+func (e *FlowEdge) String() string {
+	return fmt.Sprintf("%d->%d %.5f/%.5f", e.v, e.w, e.flow, e.capacity)
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// FlowNetwork represents a capacitated network of vertices named 0 through v – 1, where each directed edge is
+// of type FlowEdge and has a real-valued capacity and flow. This implementation uses an adjacency-lists
+// representation, which is a vertex-indexed array of Bags. Each edge appears in the adjacency lists of both of
+// its endpoints, so that the residual network can be traversed in both directions.
+// Parallel edges and self-loops are permitted.
+// It uses O(V + E) space, where V is the number of vertices and E is the number of edges.
+type FlowNetwork struct {
+	v   int                           // number of vertices
+	e   int                           // number of edges
+	adj []*fundamental.Bag[*FlowEdge] // edges incident to each vertex
+}
+
+// NewFlowNetwork initializes an empty flow network with v number vertices
+// The complexity is O(V), where V is the number of vertices.
+func NewFlowNetwork(v int) (*FlowNetwork, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+
+	adj := make([]*fundamental.Bag[*FlowEdge], v)
+	for i := 0; i < v; i++ {
+		adj[i] = fundamental.NewBag[*FlowEdge]()
+	}
+
+	return &FlowNetwork{
+		v:   v,
+		e:   0,
+		adj: adj,
+	}, nil
+}
+
+// V returns the number of vertices.
+// The complexity is O(1).
+func (network *FlowNetwork) V() int {
+	return network.v
+}
+
+// E returns the number of edges.
+// The complexity is O(1).
+func (network *FlowNetwork) E() int {
+	return network.e
+}
+
+func (network *FlowNetwork) validateVertex(v int) error {
+	if v < 0 || v >= network.v {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// AddEdge adds the edge e.
+// The complexity is O(1).
+func (network *FlowNetwork) AddEdge(e *FlowEdge) error {
+	if err := network.validateVertex(e.From()); err != nil {
+		return err
+	}
+	if err := network.validateVertex(e.To()); err != nil {
+		return err
+	}
+	network.e++
+	network.adj[e.From()].Add(e)
+	network.adj[e.To()].Add(e)
+	return nil
+}
+
+// Adj returns an iterator that iterates over edges incident to vertex v (both leaving and entering v).
+// The complexity is O(1) (Though, iterating over the edges returned by Adj(v) takes time proportional to the
+// number of edges incident to the vertex v).
+func (network *FlowNetwork) Adj(v int) (iter.Seq[*FlowEdge], error) {
+	if err := network.validateVertex(v); err != nil {
+		return nil, err
+	}
+	return network.adj[v].Iterator(), nil
+}
+
+// HasEdge returns true if the network has an edge v->w (of any capacity).
+// The complexity is O(min(deg(v), deg(w))), where deg counts the edges both leaving and entering a vertex.
+func (network *FlowNetwork) HasEdge(v, w int) (bool, error) {
+	if err := network.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := network.validateVertex(w); err != nil {
+		return false, err
+	}
+	// the edge is in the adjacency lists of both endpoints, so search the shorter one
+	x := v
+	if network.adj[v].Size() > network.adj[w].Size() {
+		x = w
+	}
+	for e := range network.adj[x].Iterator() {
+		if e.From() == v && e.To() == w {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Edges returns an iterator that iterates over all edges in the flow network.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (network *FlowNetwork) Edges() iter.Seq[*FlowEdge] {
+	edges := fundamental.NewBag[*FlowEdge]()
+	for v := 0; v < network.v; v++ {
+		selfLoops := 0
+		for e := range network.adj[v].Iterator() {
+			if e.From() != v {
+				continue
+			}
+			// add only one copy of each self loop (self loops will be consecutive)
+			if e.To() == v {
+				if selfLoops%2 == 0 {
+					edges.Add(e)
+				}
+				selfLoops++
+			} else {
+				edges.Add(e)
+			}
+		}
+	}
+	return edges.Iterator()
+}
+
+// excess returns the net flow into vertex v (inflow - outflow).
+// The complexity is O(deg(v)).
+func (network *FlowNetwork) excess(v int) float64 {
+	excess := 0.0
+	for e := range network.adj[v].Iterator() {
+		if e.From() == v {
+			excess -= e.Flow()
+		}
+		if e.To() == v {
+			excess += e.Flow()
+		}
+	}
+	return excess
+}
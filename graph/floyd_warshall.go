@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// FloydWarshall represents a data type for solving the all-pairs shortest paths problem in edge-weighted digraphs
+// with no negative cycles. The edge weights can be positive, negative, or zero.
+// This implementation also determines whether the edge-weighted digraph has a negative cycle and, if so, finds
+// such a cycle.
+// This implementation uses the Floyd-Warshall algorithm.
+// It uses O(V²) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type FloydWarshall struct {
+	hasNegativeCycle bool              // is there a negative cycle?
+	distTo           [][]float64       // distTo[v][w] = length of shortest v->w path
+	edgeTo           [][]*DirectedEdge // edgeTo[v][w] = last edge on shortest v->w path
+}
+
+// NewFloydWarshall computes a shortest paths tree from each vertex to every other vertex in the edge-weighted
+// digraph, or finds a negative cycle.
+// The complexity is O(V³), where V is the number of vertices.
+func NewFloydWarshall(digraph *AdjMatrixEdgeWeightedDigraph) *FloydWarshall {
+	v := digraph.V()
+	f := &FloydWarshall{
+		hasNegativeCycle: false,
+		distTo:           make([][]float64, v),
+		edgeTo:           make([][]*DirectedEdge, v),
+	}
+	for i := 0; i < v; i++ {
+		f.distTo[i] = make([]float64, v)
+		f.edgeTo[i] = make([]*DirectedEdge, v)
+		for j := 0; j < v; j++ {
+			f.distTo[i][j] = math.Inf(1)
+		}
+	}
+
+	// initialize distances using edge-weighted digraph's
+	for i := 0; i < v; i++ {
+		adj, _ := digraph.Adj(i)
+		for e := range adj {
+			f.distTo[e.From()][e.To()] = e.Weight()
+			f.edgeTo[e.From()][e.To()] = &e
+		}
+		// in case of self-loops
+		if f.distTo[i][i] >= 0 {
+			f.distTo[i][i] = 0
+			f.edgeTo[i][i] = nil
+		}
+	}
+
+	// Floyd-Warshall updates
+	for i := 0; i < v; i++ {
+		// compute shortest paths using only 0, 1, ..., i as intermediate vertices
+		for s := 0; s < v; s++ {
+			// optimization
+			if f.edgeTo[s][i] == nil {
+				continue
+			}
+			for t := 0; t < v; t++ {
+				if f.distTo[s][t] > f.distTo[s][i]+f.distTo[i][t] {
+					f.distTo[s][t] = f.distTo[s][i] + f.distTo[i][t]
+					f.edgeTo[s][t] = f.edgeTo[i][t]
+				}
+			}
+			// check for negative cycle
+			if f.distTo[s][s] < 0 {
+				f.hasNegativeCycle = true
+				return f
+			}
+		}
+	}
+	return f
+}
+
+// HasNegativeCycle returns true if there is a negative cycle in the edge-weighted digraph.
+// The complexity is O(1).
+func (f *FloydWarshall) HasNegativeCycle() bool {
+	return f.hasNegativeCycle
+}
+
+// NegativeCycle returns an iterator that iterates over the edges of a negative cycle, or an empty iterator if
+// there is no such cycle.
+// The complexity is O(V²), where V is the number of vertices.
+func (f *FloydWarshall) NegativeCycle() iter.Seq[DirectedEdge] {
+	for v := 0; v < len(f.distTo); v++ {
+		// negative cycle in v's predecessor graph
+		if f.distTo[v][v] < 0 {
+			spt, _ := NewEdgeWeightedDigraph(len(f.distTo))
+			for w := 0; w < len(f.distTo); w++ {
+				if f.edgeTo[v][w] != nil {
+					spt.AddEdge(*f.edgeTo[v][w])
+				}
+			}
+			finder := NewEdgeWeightedDirectedCycle(spt)
+			return finder.Cycle()
+		}
+	}
+	return fundamental.NewStack[DirectedEdge]().Iterator()
+}
+
+// HasPath returns true if there is a path from vertex s to vertex t,
+// ErrNegativeCycle if there is a negative cost cycle.
+// The complexity is O(1).
+func (f *FloydWarshall) HasPath(s, t int) (bool, error) {
+	if err := f.validateVertex(s); err != nil {
+		return false, err
+	}
+	if err := f.validateVertex(t); err != nil {
+		return false, err
+	}
+	if f.hasNegativeCycle {
+		return false, ErrNegativeCycle
+	}
+	return f.distTo[s][t] < math.Inf(1), nil
+}
+
+// Dist returns the length of a shortest path from vertex s to vertex t, or returns +Inf if there is no path,
+// ErrNegativeCycle if there is a negative cost cycle.
+// The complexity is O(1).
+func (f *FloydWarshall) Dist(s, t int) (float64, error) {
+	if hasPath, err := f.HasPath(s, t); !hasPath {
+		return math.Inf(1), err
+	}
+	return f.distTo[s][t], nil
+}
+
+// Path returns an iterator that iterates over the edges of a shortest path from vertex s to vertex t,
+// ErrNegativeCycle if there is a negative cost cycle.
+func (f *FloydWarshall) Path(s, t int) (iter.Seq[DirectedEdge], error) {
+	path := fundamental.NewStack[DirectedEdge]()
+	if hasPath, err := f.HasPath(s, t); !hasPath {
+		return path.Iterator(), err
+	}
+	for e := f.edgeTo[s][t]; e != nil; e = f.edgeTo[s][e.From()] {
+		path.Push(*e)
+	}
+	return path.Iterator(), nil
+}
+
+func (f *FloydWarshall) validateVertex(v int) error {
+	if v < 0 || v >= len(f.distTo) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
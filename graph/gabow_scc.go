@@ -0,0 +1,123 @@
+package graph
+
+import "github.com/inpour/algorithms/fundamental"
+
+// GabowSCC (Gabow strongly connected components) represents a data type for determining the
+// strongly connected components (or strong components for short) in a digraph.
+// This implementation uses Gabow's path-based algorithm, which needs a single depth-first search and no
+// reverse digraph.
+// The component identifier (id) of a vertex is an integer between 0 and k–1, where k is the number
+// of strong components. Two vertices have the same component identifier if and only if they are
+// in the same strong component.
+// It uses O(V) extra space (not including the graph), where V is the number of vertices.
+type GabowSCC struct {
+	marked   []bool                  // marked[v] = has v been visited?
+	id       []int                   // id[v] = id of strong component containing v, -1 if not yet assigned
+	size     []int                   // size[id] = number of vertices in given strong component
+	preorder []int                   // preorder[v] = preorder of v
+	pre      int                     // preorder number counter
+	count    int                     // number of strong components
+	stack1   *fundamental.Stack[int] // vertices of the strong components not yet identified
+	stack2   *fundamental.Stack[int] // roots of the strong components candidates on the current path
+}
+
+// NewGabowSCC computes the strong components of the digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewGabowSCC(digraph AdjacencyGraph) *GabowSCC {
+	g := &GabowSCC{
+		marked:   make([]bool, digraph.V()),
+		id:       make([]int, digraph.V()),
+		size:     make([]int, digraph.V()),
+		preorder: make([]int, digraph.V()),
+		pre:      0,
+		count:    0,
+		stack1:   fundamental.NewStack[int](),
+		stack2:   fundamental.NewStack[int](),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		g.id[v] = -1
+	}
+	for v := 0; v < digraph.V(); v++ {
+		if !g.marked[v] {
+			g.dfs(digraph, v)
+		}
+	}
+	return g
+}
+
+// dfs (depth first search) from v
+func (g *GabowSCC) dfs(digraph AdjacencyGraph, v int) {
+	g.marked[v] = true
+	g.preorder[v] = g.pre
+	g.pre++
+	g.stack1.Push(v)
+	g.stack2.Push(v)
+	adj, _ := digraph.Adj(v)
+	for w := range adj {
+		if !g.marked[w] {
+			g.dfs(digraph, w)
+		} else if g.id[w] == -1 {
+			// w is on the current path, contract the cycle
+			for top, _ := g.stack2.Peek(); g.preorder[top] > g.preorder[w]; top, _ = g.stack2.Peek() {
+				g.stack2.Pop()
+			}
+		}
+	}
+
+	// v is the root of a strong component, pop it off the stack
+	if top, _ := g.stack2.Peek(); top == v {
+		g.stack2.Pop()
+		for {
+			w, _ := g.stack1.Pop()
+			g.id[w] = g.count
+			g.size[g.count]++
+			if w == v {
+				break
+			}
+		}
+		g.count++
+	}
+}
+
+// ID returns the component id of the strong component containing vertex v.
+// The complexity is O(1).
+func (g *GabowSCC) ID(v int) (int, error) {
+	if err := g.validateVertex(v); err != nil {
+		return 0, err
+	}
+	return g.id[v], nil
+}
+
+// Size returns the number of vertices in the strong component containing vertex v.
+// The complexity is O(1).
+func (g *GabowSCC) Size(v int) (int, error) {
+	if err := g.validateVertex(v); err != nil {
+		return 0, err
+	}
+	return g.size[g.id[v]], nil
+}
+
+// Count returns the number of strong components.
+// The complexity is O(1).
+func (g *GabowSCC) Count() int {
+	return g.count
+}
+
+// StronglyConnected returns true if vertices v and w are in the same strong component.
+// The complexity is O(1).
+func (g *GabowSCC) StronglyConnected(v, w int) (bool, error) {
+	if err := g.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := g.validateVertex(w); err != nil {
+		return false, err
+	}
+	return g.id[v] == g.id[w], nil
+}
+
+func (g *GabowSCC) validateVertex(v int) error {
+	if v < 0 || v >= len(g.marked) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -0,0 +1,533 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Generator generates random and structured graphs and digraphs, for testing algorithms on many inputs.
+// All the randomness comes from the rand.Source given to NewGenerator, so a generator created from a source with
+// the same seed generates the same sequence of graphs.
+// In the structured graphs (such as paths, cycles, stars and wheels) the vertices are randomly relabeled, except for
+// complete graphs and grids, whose labels are meaningful.
+type Generator struct {
+	rand *rand.Rand
+}
+
+// NewGenerator initializes a Generator that uses source as its source of randomness.
+// The complexity is O(1).
+func NewGenerator(source rand.Source) *Generator {
+	return &Generator{rand: rand.New(source)}
+}
+
+var ErrInvalidProbability = errors.New("probability must be between 0 and 1")
+var ErrTooManyEdges = errors.New("too many edges for a simple graph")
+var ErrTooFewVertices = errors.New("too few vertices for the requested graph")
+var ErrInvalidDegree = errors.New("degree must be non-negative and the sum of degrees must be even")
+
+// validateCounts validates the number of vertices v and the number of edges e
+func validateCounts(v, e int) error {
+	if v < 0 {
+		return ErrInvalidVertices
+	}
+	if e < 0 {
+		return ErrInvalidEdges
+	}
+	return nil
+}
+
+// sample returns m distinct integers chosen uniformly at random from [0, n), in random order.
+// This implementation uses Floyd's sampling algorithm, so it takes O(m) time even if m is close to n.
+func (g *Generator) sample(n int64, m int) []int64 {
+	chosen := make(map[int64]bool, m)
+	samples := make([]int64, 0, m)
+	for j := n - int64(m); j < n; j++ {
+		t := g.rand.Int63n(j + 1)
+		if chosen[t] {
+			t = j
+		}
+		chosen[t] = true
+		samples = append(samples, t)
+	}
+	g.rand.Shuffle(len(samples), func(i, j int) {
+		samples[i], samples[j] = samples[j], samples[i]
+	})
+	return samples
+}
+
+// pair returns the k-th pair (i, j) with 0 <= j < i, in the order (1, 0), (2, 0), (2, 1), (3, 0), ...
+func pair(k int64) (int, int) {
+	i := int64((1 + math.Sqrt(float64(1+8*k))) / 2)
+	// correct floating-point rounding
+	for i*(i-1)/2 > k {
+		i--
+	}
+	for (i+1)*i/2 <= k {
+		i++
+	}
+	return int(i), int(k - i*(i-1)/2)
+}
+
+// pairs returns the number of pairs (i, j) with 0 <= j < i < v
+func pairs(v int) int64 {
+	return int64(v) * int64(v-1) / 2
+}
+
+// Multigraph returns a random graph with v vertices and e edges, where each edge connects two vertices chosen
+// uniformly at random (parallel edges and self-loops may appear).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) Multigraph(v, e int) (*Graph, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if v == 0 && e > 0 {
+		return nil, ErrTooFewVertices
+	}
+	graph, _ := NewGraph(v)
+	for i := 0; i < e; i++ {
+		graph.AddEdge(g.rand.Intn(v), g.rand.Intn(v))
+	}
+	return graph, nil
+}
+
+// Simple returns a random simple graph (no parallel edges or self-loops) with v vertices and e edges, chosen
+// uniformly among all such graphs (the Erdős–Rényi G(n, m) model), ErrTooManyEdges if e > v*(v-1)/2.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) Simple(v, e int) (*Graph, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if int64(e) > pairs(v) {
+		return nil, ErrTooManyEdges
+	}
+	graph, _ := NewGraph(v)
+	for _, k := range g.sample(pairs(v), e) {
+		graph.AddEdge(pair(k))
+	}
+	return graph, nil
+}
+
+// ErdosRenyi returns a random simple graph with v vertices, where each of the v*(v-1)/2 possible edges is present
+// independently with probability p (the Erdős–Rényi G(n, p) model).
+// The complexity is O(V^2), where V is the number of vertices.
+func (g *Generator) ErdosRenyi(v int, p float64) (*Graph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	if !(p >= 0 && p <= 1) {
+		return nil, ErrInvalidProbability
+	}
+	graph, _ := NewGraph(v)
+	for i := 0; i < v; i++ {
+		for j := i + 1; j < v; j++ {
+			if g.rand.Float64() < p {
+				graph.AddEdge(i, j)
+			}
+		}
+	}
+	return graph, nil
+}
+
+// Bipartite returns a random simple bipartite graph with v1 vertices on one side, v2 vertices on the other side and
+// e edges, chosen uniformly among all such graphs, ErrTooManyEdges if e > v1*v2.
+// The vertices of the two sides are randomly interleaved.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) Bipartite(v1, v2, e int) (*Graph, error) {
+	if v1 < 0 || v2 < 0 {
+		return nil, ErrInvalidVertices
+	}
+	if e < 0 {
+		return nil, ErrInvalidEdges
+	}
+	if int64(e) > int64(v1)*int64(v2) {
+		return nil, ErrTooManyEdges
+	}
+	graph, _ := NewGraph(v1 + v2)
+	vertices := g.rand.Perm(v1 + v2)
+	for _, k := range g.sample(int64(v1)*int64(v2), e) {
+		graph.AddEdge(vertices[k/int64(v2)], vertices[int64(v1)+k%int64(v2)])
+	}
+	return graph, nil
+}
+
+// Regular returns a random k-regular graph with v vertices, where every vertex has degree k, ErrInvalidDegree if
+// k < 0 or v*k is odd.
+// This implementation uses the configuration model, which pairs up v*k edge endpoints uniformly at random, so the
+// graph may have parallel edges and self-loops.
+// The complexity is O(V*k), where V is the number of vertices.
+func (g *Generator) Regular(v, k int) (*Graph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	if k < 0 || v*k%2 != 0 {
+		return nil, ErrInvalidDegree
+	}
+	graph, _ := NewGraph(v)
+	endpoints := make([]int, 0, v*k)
+	for i := 0; i < v; i++ {
+		for j := 0; j < k; j++ {
+			endpoints = append(endpoints, i)
+		}
+	}
+	g.rand.Shuffle(len(endpoints), func(i, j int) {
+		endpoints[i], endpoints[j] = endpoints[j], endpoints[i]
+	})
+	for i := 0; i < len(endpoints); i += 2 {
+		graph.AddEdge(endpoints[i], endpoints[i+1])
+	}
+	return graph, nil
+}
+
+// Tree returns a random tree with v vertices, chosen uniformly among all the labeled trees.
+// This implementation decodes a random Prüfer sequence.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) Tree(v int) (*Graph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	graph, _ := NewGraph(v)
+	if v <= 1 {
+		return graph, nil
+	}
+
+	prufer := make([]int, v-2)
+	degree := make([]int, v)
+	for i := range degree {
+		degree[i] = 1
+	}
+	for i := range prufer {
+		prufer[i] = g.rand.Intn(v)
+		degree[prufer[i]]++
+	}
+
+	// repeatedly connect the smallest leaf to the next vertex of the sequence
+	ptr := 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+	leaf := ptr
+	for _, x := range prufer {
+		graph.AddEdge(leaf, x)
+		degree[leaf]--
+		degree[x]--
+		if degree[x] == 1 && x < ptr {
+			leaf = x
+		} else {
+			ptr++
+			for degree[ptr] != 1 {
+				ptr++
+			}
+			leaf = ptr
+		}
+	}
+	graph.AddEdge(leaf, v-1)
+	return graph, nil
+}
+
+// Complete returns the complete graph with v vertices.
+// The complexity is O(V^2), where V is the number of vertices.
+func (g *Generator) Complete(v int) (*Graph, error) {
+	return g.ErdosRenyi(v, 1)
+}
+
+// Grid returns the grid graph with rows*cols vertices, where vertex r*cols + c (for row r and column c) is adjacent
+// to the vertices above, below, to the left and to the right of it.
+// The complexity is O(rows*cols).
+func (g *Generator) Grid(rows, cols int) (*Graph, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvalidVertices
+	}
+	graph, _ := NewGraph(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			v := r*cols + c
+			if c+1 < cols {
+				graph.AddEdge(v, v+1)
+			}
+			if r+1 < rows {
+				graph.AddEdge(v, v+cols)
+			}
+		}
+	}
+	return graph, nil
+}
+
+// Path returns a path graph with v vertices, visiting the vertices in random order.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) Path(v int) (*Graph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	graph, _ := NewGraph(v)
+	vertices := g.rand.Perm(v)
+	for i := 0; i+1 < v; i++ {
+		graph.AddEdge(vertices[i], vertices[i+1])
+	}
+	return graph, nil
+}
+
+// Cycle returns a cycle graph with v vertices, visiting the vertices in random order, ErrTooFewVertices if v < 3.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) Cycle(v int) (*Graph, error) {
+	if v < 3 {
+		return nil, ErrTooFewVertices
+	}
+	graph, _ := NewGraph(v)
+	vertices := g.rand.Perm(v)
+	for i := 0; i < v; i++ {
+		graph.AddEdge(vertices[i], vertices[(i+1)%v])
+	}
+	return graph, nil
+}
+
+// Star returns a star graph with v vertices, a random center vertex adjacent to all the other vertices,
+// ErrTooFewVertices if v < 1.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) Star(v int) (*Graph, error) {
+	if v < 1 {
+		return nil, ErrTooFewVertices
+	}
+	graph, _ := NewGraph(v)
+	center := g.rand.Intn(v)
+	for w := 0; w < v; w++ {
+		if w != center {
+			graph.AddEdge(center, w)
+		}
+	}
+	return graph, nil
+}
+
+// Wheel returns a wheel graph with v vertices, a random hub vertex adjacent to all the vertices of a cycle on the
+// other v-1 vertices, ErrTooFewVertices if v < 4.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) Wheel(v int) (*Graph, error) {
+	if v < 4 {
+		return nil, ErrTooFewVertices
+	}
+	graph, _ := NewGraph(v)
+	vertices := g.rand.Perm(v)
+	hub, rim := vertices[0], vertices[1:]
+	for i, w := range rim {
+		graph.AddEdge(hub, w)
+		graph.AddEdge(w, rim[(i+1)%len(rim)])
+	}
+	return graph, nil
+}
+
+// EulerianCycle returns a random graph with v vertices and e edges that has an Eulerian cycle, the edges of a random
+// closed walk (parallel edges and self-loops may appear), ErrTooFewVertices if v < 1 and e > 0.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) EulerianCycle(v, e int) (*Graph, error) {
+	walk, err := g.walk(v, e, true)
+	if err != nil {
+		return nil, err
+	}
+	graph, _ := NewGraph(v)
+	for i := 0; i < e; i++ {
+		graph.AddEdge(walk[i], walk[i+1])
+	}
+	return graph, nil
+}
+
+// EulerianPath returns a random graph with v vertices and e edges that has an Eulerian path, the edges of a random
+// walk (parallel edges and self-loops may appear, and the walk may be closed), ErrTooFewVertices if v < 1 and e > 0.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) EulerianPath(v, e int) (*Graph, error) {
+	walk, err := g.walk(v, e, false)
+	if err != nil {
+		return nil, err
+	}
+	graph, _ := NewGraph(v)
+	for i := 0; i < e; i++ {
+		graph.AddEdge(walk[i], walk[i+1])
+	}
+	return graph, nil
+}
+
+// walk returns e+1 random vertices (the vertices of a walk with e edges), with the last vertex equal to the first
+// one if closed is true
+func (g *Generator) walk(v, e int, closed bool) ([]int, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if v == 0 && e > 0 {
+		return nil, ErrTooFewVertices
+	}
+	if e == 0 {
+		return nil, nil
+	}
+	walk := make([]int, e+1)
+	for i := range walk {
+		walk[i] = g.rand.Intn(v)
+	}
+	if closed {
+		walk[e] = walk[0]
+	}
+	return walk, nil
+}
+
+// MultiDigraph returns a random digraph with v vertices and e edges, where each edge connects two vertices chosen
+// uniformly at random (parallel edges and self-loops may appear).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) MultiDigraph(v, e int) (*Digraph, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if v == 0 && e > 0 {
+		return nil, ErrTooFewVertices
+	}
+	digraph, _ := NewDigraph(v)
+	for i := 0; i < e; i++ {
+		digraph.AddEdge(g.rand.Intn(v), g.rand.Intn(v))
+	}
+	return digraph, nil
+}
+
+// SimpleDigraph returns a random simple digraph (no parallel edges or self-loops) with v vertices and e edges,
+// chosen uniformly among all such digraphs, ErrTooManyEdges if e > v*(v-1).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) SimpleDigraph(v, e int) (*Digraph, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if int64(e) > 2*pairs(v) {
+		return nil, ErrTooManyEdges
+	}
+	digraph, _ := NewDigraph(v)
+	for _, k := range g.sample(2*pairs(v), e) {
+		from, to := int(k/int64(v-1)), int(k%int64(v-1))
+		if to >= from {
+			to++
+		}
+		digraph.AddEdge(from, to)
+	}
+	return digraph, nil
+}
+
+// ErdosRenyiDigraph returns a random simple digraph with v vertices, where each of the v*(v-1) possible edges is
+// present independently with probability p.
+// The complexity is O(V^2), where V is the number of vertices.
+func (g *Generator) ErdosRenyiDigraph(v int, p float64) (*Digraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	if !(p >= 0 && p <= 1) {
+		return nil, ErrInvalidProbability
+	}
+	digraph, _ := NewDigraph(v)
+	for i := 0; i < v; i++ {
+		for j := 0; j < v; j++ {
+			if i != j && g.rand.Float64() < p {
+				digraph.AddEdge(i, j)
+			}
+		}
+	}
+	return digraph, nil
+}
+
+// CompleteDigraph returns the complete digraph with v vertices, with an edge in both directions between every two
+// vertices.
+// The complexity is O(V^2), where V is the number of vertices.
+func (g *Generator) CompleteDigraph(v int) (*Digraph, error) {
+	return g.ErdosRenyiDigraph(v, 1)
+}
+
+// DAG returns a random simple directed acyclic graph with v vertices and e edges, chosen uniformly among the DAGs
+// that are consistent with a random topological order, ErrTooManyEdges if e > v*(v-1)/2.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) DAG(v, e int) (*Digraph, error) {
+	if err := validateCounts(v, e); err != nil {
+		return nil, err
+	}
+	if int64(e) > pairs(v) {
+		return nil, ErrTooManyEdges
+	}
+	digraph, _ := NewDigraph(v)
+	vertices := g.rand.Perm(v)
+	for _, k := range g.sample(pairs(v), e) {
+		i, j := pair(k)
+		digraph.AddEdge(vertices[j], vertices[i])
+	}
+	return digraph, nil
+}
+
+// Tournament returns a random tournament with v vertices, a digraph with exactly one edge, in a random direction,
+// between every two vertices.
+// The complexity is O(V^2), where V is the number of vertices.
+func (g *Generator) Tournament(v int) (*Digraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	digraph, _ := NewDigraph(v)
+	for i := 0; i < v; i++ {
+		for j := i + 1; j < v; j++ {
+			if g.rand.Intn(2) == 0 {
+				digraph.AddEdge(i, j)
+			} else {
+				digraph.AddEdge(j, i)
+			}
+		}
+	}
+	return digraph, nil
+}
+
+// DirectedPath returns a directed path with v vertices, visiting the vertices in random order.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) DirectedPath(v int) (*Digraph, error) {
+	if v < 0 {
+		return nil, ErrInvalidVertices
+	}
+	digraph, _ := NewDigraph(v)
+	vertices := g.rand.Perm(v)
+	for i := 0; i+1 < v; i++ {
+		digraph.AddEdge(vertices[i], vertices[i+1])
+	}
+	return digraph, nil
+}
+
+// DirectedCycle returns a directed cycle with v vertices, visiting the vertices in random order,
+// ErrTooFewVertices if v < 2.
+// The complexity is O(V), where V is the number of vertices.
+func (g *Generator) DirectedCycle(v int) (*Digraph, error) {
+	if v < 2 {
+		return nil, ErrTooFewVertices
+	}
+	digraph, _ := NewDigraph(v)
+	vertices := g.rand.Perm(v)
+	for i := 0; i < v; i++ {
+		digraph.AddEdge(vertices[i], vertices[(i+1)%v])
+	}
+	return digraph, nil
+}
+
+// EulerianCycleDigraph returns a random digraph with v vertices and e edges that has a directed Eulerian cycle,
+// the edges of a random closed walk (parallel edges and self-loops may appear), ErrTooFewVertices if v < 1 and e > 0.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) EulerianCycleDigraph(v, e int) (*Digraph, error) {
+	walk, err := g.walk(v, e, true)
+	if err != nil {
+		return nil, err
+	}
+	digraph, _ := NewDigraph(v)
+	for i := 0; i < e; i++ {
+		digraph.AddEdge(walk[i], walk[i+1])
+	}
+	return digraph, nil
+}
+
+// EulerianPathDigraph returns a random digraph with v vertices and e edges that has a directed Eulerian path,
+// the edges of a random walk (parallel edges and self-loops may appear, and the walk may be closed),
+// ErrTooFewVertices if v < 1 and e > 0.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (g *Generator) EulerianPathDigraph(v, e int) (*Digraph, error) {
+	walk, err := g.walk(v, e, false)
+	if err != nil {
+		return nil, err
+	}
+	digraph, _ := NewDigraph(v)
+	for i := 0; i < e; i++ {
+		digraph.AddEdge(walk[i], walk[i+1])
+	}
+	return digraph, nil
+}
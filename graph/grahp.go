@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"errors"
 	"github.com/inpour/algorithms/fundamental"
 	"iter"
 )
@@ -87,3 +88,97 @@ func (graph *Graph) Degree(v int) (int, error) {
 	}
 	return graph.adj[v].Size(), nil
 }
+
+// RemoveEdge removes one copy of the undirected edge v-w, ErrEdgeNotFound if there is no such edge.
+// The complexity is O(deg(v) + deg(w)).
+func (graph *Graph) RemoveEdge(v, w int) error {
+	if err := graph.validateVertex(v); err != nil {
+		return err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return err
+	}
+	if !graph.adj[v].Remove(func(x int) bool { return x == w }) {
+		return ErrEdgeNotFound
+	}
+	// a self-loop v-v appears in the adjacency list of v twice
+	graph.adj[w].Remove(func(x int) bool { return x == v })
+	graph.e--
+	return nil
+}
+
+// AddVertex adds an isolated vertex and returns its index (the previous number of vertices).
+// The complexity is O(1) (amortized).
+func (graph *Graph) AddVertex() int {
+	graph.adj = append(graph.adj, fundamental.NewBag[int]())
+	graph.v++
+	return graph.v - 1
+}
+
+// RemoveVertex removes vertex v and all its incident edges. The vertices after v are renumbered down by one
+// (vertex w > v becomes w - 1), so indices held outside the graph must be updated (SymbolGraph.RemoveVertex does
+// this for its names).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (graph *Graph) RemoveVertex(v int) error {
+	if err := graph.validateVertex(v); err != nil {
+		return err
+	}
+	// a self-loop v-v contributes two to the degree of v but is a single edge
+	selfLoops := 0
+	for w := range graph.adj[v].Iterator() {
+		if w == v {
+			selfLoops++
+		}
+	}
+	graph.e -= graph.adj[v].Size() - selfLoops/2
+	graph.adj = removeVertex(graph.adj, v)
+	graph.v--
+	return nil
+}
+
+// removeVertex returns the adjacency lists without vertex v, with the occurrences of v removed and the vertices
+// after v renumbered down by one, keeping the order of each list
+func removeVertex(adj []*fundamental.Bag[int], v int) []*fundamental.Bag[int] {
+	adj = append(adj[:v], adj[v+1:]...)
+	for i, bag := range adj {
+		var items []int
+		for w := range bag.Iterator() {
+			if w != v {
+				items = append(items, w)
+			}
+		}
+		// a Bag iterates in reverse order of insertion
+		renumbered := fundamental.NewBag[int]()
+		for j := len(items) - 1; j >= 0; j-- {
+			w := items[j]
+			if w > v {
+				w--
+			}
+			renumbered.Add(w)
+		}
+		adj[i] = renumbered
+	}
+	return adj
+}
+
+var ErrEdgeNotFound = errors.New("edge not found")
+
+// HasEdge returns true if the graph has the undirected edge v-w.
+// The complexity is O(min(deg(v), deg(w))).
+func (graph *Graph) HasEdge(v, w int) (bool, error) {
+	if err := graph.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := graph.validateVertex(w); err != nil {
+		return false, err
+	}
+	if graph.adj[v].Size() > graph.adj[w].Size() {
+		v, w = w, v
+	}
+	for x := range graph.adj[v].Iterator() {
+		if x == w {
+			return true, nil
+		}
+	}
+	return false, nil
+}
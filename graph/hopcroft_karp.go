@@ -0,0 +1,188 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"math"
+)
+
+// HopcroftKarp represents a data type for computing a maximum (cardinality) matching and a minimum (cardinality)
+// vertex cover in a bipartite graph. A bipartite graph is a graph whose vertices can be partitioned into two
+// disjoint sets such that every edge has one endpoint in either set. A matching in a graph is a subset of its edges
+// with no common vertices. A vertex cover in a graph is a subset of its vertices such that every edge is incident
+// to at least one vertex in the subset.
+// This implementation uses the Hopcroft-Karp algorithm on the bipartition found by Bipartite, and König's theorem
+// to derive a minimum vertex cover from the maximum matching.
+// It uses O(V) extra space (not including the graph), where V is the number of vertices.
+type HopcroftKarp struct {
+	color       []bool // color[v] = side of the bipartition that v is on (false for the left side)
+	mate        []int  // mate[v] = w if v-w is an edge in the current matching, -1 if v is unmatched
+	cardinality int    // cardinality of current matching
+	dist        []int  // dist[v] = length of shortest alternating path from a free left vertex to left vertex v
+	freeDist    int    // length of shortest augmenting path in the current phase, as dist of its free right end
+	inCover     []bool // inCover[v] = is v in the minimum vertex cover?
+}
+
+// NewHopcroftKarp determines a maximum matching (and a minimum vertex cover) in a bipartite graph,
+// ErrGraphIsNotBipartite if the graph is not bipartite.
+// The complexity is O((E + V)*sqrt(V)), where V is the number of vertices and E is the number of edges.
+func NewHopcroftKarp(graph AdjacencyGraph) (*HopcroftKarp, error) {
+	bipartite := NewBipartite(graph)
+	if !bipartite.IsBipartite() {
+		return nil, ErrGraphIsNotBipartite
+	}
+
+	h := &HopcroftKarp{
+		color:       make([]bool, graph.V()),
+		mate:        make([]int, graph.V()),
+		cardinality: 0,
+		dist:        make([]int, graph.V()),
+		inCover:     make([]bool, graph.V()),
+	}
+	for v := 0; v < graph.V(); v++ {
+		h.color[v], _ = bipartite.Color(v)
+		h.mate[v] = -1
+	}
+
+	// each phase augments along a maximal set of vertex-disjoint shortest augmenting paths
+	for h.bfs(graph) {
+		for v := 0; v < graph.V(); v++ {
+			if !h.color[v] && h.mate[v] == -1 && h.dfs(graph, v) {
+				h.cardinality++
+			}
+		}
+	}
+	h.findMinVertexCover(graph)
+	return h, nil
+}
+
+// bfs (breadth first search) computes the layers of alternating paths from the free left vertices, returns true
+// if there is an augmenting path
+func (h *HopcroftKarp) bfs(graph AdjacencyGraph) bool {
+	q := fundamental.NewQueue[int]()
+	for v := 0; v < graph.V(); v++ {
+		h.dist[v] = math.MaxInt
+		if !h.color[v] && h.mate[v] == -1 {
+			h.dist[v] = 0
+			q.Enqueue(v)
+		}
+	}
+	// stop at the layer of the first free right vertex, so that only shortest augmenting paths are used
+	h.freeDist = math.MaxInt
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		if h.dist[v] >= h.freeDist {
+			continue
+		}
+		adj, _ := graph.Adj(v)
+		for w := range adj {
+			u := h.mate[w]
+			if u == -1 {
+				if h.freeDist == math.MaxInt {
+					h.freeDist = h.dist[v] + 1
+				}
+			} else if h.dist[u] == math.MaxInt {
+				h.dist[u] = h.dist[v] + 1
+				q.Enqueue(u)
+			}
+		}
+	}
+	return h.freeDist != math.MaxInt
+}
+
+// dfs (depth first search) finds an augmenting path from left vertex v along the layers and flips it,
+// returns true if such a path was found
+func (h *HopcroftKarp) dfs(graph AdjacencyGraph, v int) bool {
+	adj, _ := graph.Adj(v)
+	for w := range adj {
+		u := h.mate[w]
+		var found bool
+		if u == -1 {
+			// a free right vertex ends an augmenting path only on the last layer
+			found = h.dist[v]+1 == h.freeDist
+		} else {
+			found = h.dist[u] == h.dist[v]+1 && h.dfs(graph, u)
+		}
+		if found {
+			h.mate[v] = w
+			h.mate[w] = v
+			return true
+		}
+	}
+	// no augmenting path from v in this phase
+	h.dist[v] = math.MaxInt
+	return false
+}
+
+// findMinVertexCover uses König's theorem: with Z the vertices reachable by alternating paths from the free left
+// vertices, the minimum vertex cover is the left vertices not in Z plus the right vertices in Z
+func (h *HopcroftKarp) findMinVertexCover(graph AdjacencyGraph) {
+	marked := make([]bool, graph.V())
+	q := fundamental.NewQueue[int]()
+	for v := 0; v < graph.V(); v++ {
+		if !h.color[v] && h.mate[v] == -1 {
+			marked[v] = true
+			q.Enqueue(v)
+		}
+	}
+	for !q.IsEmpty() {
+		v, _ := q.Dequeue()
+		adj, _ := graph.Adj(v)
+		for w := range adj {
+			// left to right along non-matching edges, right to left along matching edges
+			if !marked[w] && (h.mate[v] != w) == !h.color[v] {
+				marked[w] = true
+				q.Enqueue(w)
+			}
+		}
+	}
+	for v := 0; v < graph.V(); v++ {
+		h.inCover[v] = marked[v] == h.color[v]
+	}
+}
+
+// Mate returns the vertex to which vertex v is matched in the maximum matching, -1 if v is unmatched.
+// The complexity is O(1).
+func (h *HopcroftKarp) Mate(v int) (int, error) {
+	if err := h.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return h.mate[v], nil
+}
+
+// IsMatched returns true if vertex v is matched in the maximum matching.
+// The complexity is O(1).
+func (h *HopcroftKarp) IsMatched(v int) (bool, error) {
+	if err := h.validateVertex(v); err != nil {
+		return false, err
+	}
+	return h.mate[v] != -1, nil
+}
+
+// Size returns the number of edges in the maximum matching.
+// The complexity is O(1).
+func (h *HopcroftKarp) Size() int {
+	return h.cardinality
+}
+
+// IsPerfect returns true if the graph has a perfect matching (every vertex is matched).
+// The complexity is O(1).
+func (h *HopcroftKarp) IsPerfect() bool {
+	return 2*h.cardinality == len(h.mate)
+}
+
+// InMinVertexCover returns true if vertex v is in the minimum vertex cover, which has the same number of vertices
+// as the maximum matching has edges and is therefore a certificate of its optimality.
+// The complexity is O(1).
+func (h *HopcroftKarp) InMinVertexCover(v int) (bool, error) {
+	if err := h.validateVertex(v); err != nil {
+		return false, err
+	}
+	return h.inCover[v], nil
+}
+
+func (h *HopcroftKarp) validateVertex(v int) error {
+	if v < 0 || v >= len(h.mate) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+// Hungarian represents a data type for solving the assignment problem: given an n-by-m matrix of costs, find a
+// minimum total cost assignment of rows to columns such that each row is assigned to at most one column, each
+// column to at most one row, and min(n, m) rows (or columns) are assigned. The costs can be positive, negative,
+// or zero.
+// This implementation uses the Hungarian algorithm (Kuhn-Munkres) with vertex potentials.
+// It uses O(n + m) extra space (not including the cost matrix).
+type Hungarian struct {
+	rowToCol []int   // rowToCol[i] = column assigned to row i, -1 if row i is unassigned
+	colToRow []int   // colToRow[j] = row assigned to column j, -1 if column j is unassigned
+	weight   float64 // total cost of the assignment
+}
+
+// NewHungarian computes a minimum cost assignment for the n-by-m matrix of costs,
+// ErrInvalidCostMatrix if the rows have different lengths or a cost is not a finite number.
+// The complexity is O(n²*m) where n <= m (the matrix is transposed otherwise).
+func NewHungarian(costs [][]float64) (*Hungarian, error) {
+	n := len(costs)
+	m := 0
+	if n > 0 {
+		m = len(costs[0])
+	}
+	for i := 0; i < n; i++ {
+		if len(costs[i]) != m {
+			return nil, ErrInvalidCostMatrix
+		}
+		for j := 0; j < m; j++ {
+			if math.IsNaN(costs[i][j]) || math.IsInf(costs[i][j], 0) {
+				return nil, ErrInvalidCostMatrix
+			}
+		}
+	}
+
+	h := &Hungarian{
+		rowToCol: make([]int, n),
+		colToRow: make([]int, m),
+		weight:   0,
+	}
+	for i := 0; i < n; i++ {
+		h.rowToCol[i] = -1
+	}
+	for j := 0; j < m; j++ {
+		h.colToRow[j] = -1
+	}
+
+	// the algorithm assigns every row, so it needs at least as many columns as rows
+	if n <= m {
+		assignment := hungarian(n, m, func(i, j int) float64 { return costs[i][j] })
+		for i, j := range assignment {
+			h.rowToCol[i] = j
+			h.colToRow[j] = i
+		}
+	} else {
+		assignment := hungarian(m, n, func(j, i int) float64 { return costs[i][j] })
+		for j, i := range assignment {
+			h.rowToCol[i] = j
+			h.colToRow[j] = i
+		}
+	}
+	for i := 0; i < n; i++ {
+		if h.rowToCol[i] != -1 {
+			h.weight += costs[i][h.rowToCol[i]]
+		}
+	}
+	return h, nil
+}
+
+var ErrInvalidCostMatrix = errors.New("cost matrix must be rectangular with finite costs")
+var ErrInvalidAssignmentIndex = errors.New("invalid row or column index")
+
+// hungarian returns the column assigned to each of the n rows of the n-by-m (n <= m) cost matrix.
+// Rows and columns are 1-based internally, with column 0 as a sentinel.
+func hungarian(n, m int, cost func(i, j int) float64) []int {
+	u := make([]float64, n+1) // row potentials
+	v := make([]float64, m+1) // column potentials
+	p := make([]int, m+1)     // p[j] = row assigned to column j, 0 if none
+	way := make([]int, m+1)   // way[j] = previous column on the alternating path to column j
+	minv := make([]float64, m+1)
+	used := make([]bool, m+1)
+
+	for i := 1; i <= n; i++ {
+		// find an augmenting path from row i, growing a tree of tight edges
+		p[0] = i
+		j0 := 0
+		for j := 0; j <= m; j++ {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				reduced := cost(i0-1, j-1) - u[i0] - v[j]
+				if reduced < minv[j] {
+					minv[j] = reduced
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			// update the potentials so that at least one more edge becomes tight
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+
+		// flip the augmenting path
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
+
+// Weight returns the total cost of the minimum cost assignment.
+// The complexity is O(1).
+func (h *Hungarian) Weight() float64 {
+	return h.weight
+}
+
+// ColumnOf returns the column assigned to row i, -1 if row i is unassigned.
+// The complexity is O(1).
+func (h *Hungarian) ColumnOf(i int) (int, error) {
+	if i < 0 || i >= len(h.rowToCol) {
+		return -1, ErrInvalidAssignmentIndex
+	}
+	return h.rowToCol[i], nil
+}
+
+// RowOf returns the row assigned to column j, -1 if column j is unassigned.
+// The complexity is O(1).
+func (h *Hungarian) RowOf(j int) (int, error) {
+	if j < 0 || j >= len(h.colToRow) {
+		return -1, ErrInvalidAssignmentIndex
+	}
+	return h.colToRow[j], nil
+}
@@ -5,13 +5,191 @@ import (
 	"iter"
 )
 
-type UndirectedOrDirectedGraph interface {
+// The algorithms access graphs through small read-only interfaces, so that any graph representation, including
+// types defined outside this package, can be used. AdjacencyGraph is all that most algorithms require; the other
+// interfaces are optional capabilities that an algorithm uses when a graph provides them (and otherwise computes
+// the same information from the adjacency lists).
+
+// AdjacencyGraph is a read-only undirected graph or digraph of vertices named 0 through V() - 1.
+// In an undirected graph, an edge v-w appears in the adjacency lists of both v and w and, by convention, a self-loop
+// v-v appears in the adjacency list of v twice.
+type AdjacencyGraph interface {
 	V() int                           // returns the number of vertices
-	E() int                           // returns the number of edges
-	validateVertex(v int) error       // validate given vertex index (v)
-	AddEdge(v, w int) error           // adds the undirected or directed edge v-w
 	Adj(v int) (iter.Seq[int], error) // returns an iterator that iterates over vertices adjacent to vertex v
 }
 
+// EdgeCountGraph is an AdjacencyGraph that knows its number of edges.
+type EdgeCountGraph interface {
+	AdjacencyGraph
+	E() int // returns the number of edges
+}
+
+// DegreeGraph is an undirected AdjacencyGraph that knows the degree of its vertices.
+type DegreeGraph interface {
+	AdjacencyGraph
+	Degree(v int) (int, error) // returns the degree of vertex v
+}
+
+// DirectedDegreeGraph is a directed AdjacencyGraph that knows the in-degree and the out-degree of its vertices.
+type DirectedDegreeGraph interface {
+	AdjacencyGraph
+	InDegree(v int) (int, error)  // returns the in-degree of vertex v
+	OutDegree(v int) (int, error) // returns the out-degree of vertex v
+}
+
+// EdgeQueryGraph is an AdjacencyGraph that can tell whether an edge exists.
+type EdgeQueryGraph interface {
+	AdjacencyGraph
+	HasEdge(v, w int) (bool, error) // returns true if there is an undirected or directed edge v-w
+}
+
+// UndirectedOrDirectedGraph is a mutable graph (or digraph) that knows its number of edges and supports adding
+// edges. It is implemented by the mutable unweighted graph representations of this package (Graph, Digraph,
+// AdjMatrixGraph and AdjMatrixDigraph); the algorithms never require it.
+type UndirectedOrDirectedGraph interface {
+	AdjacencyGraph
+	E() int                 // returns the number of edges
+	AddEdge(v, w int) error // adds the undirected or directed edge v-w
+}
+
+// StronglyConnectedComponents is the result of a strong components algorithm on a digraph, such as KosarajuSCC,
+// TarjanSCC or GabowSCC.
+type StronglyConnectedComponents interface {
+	Count() int                               // returns the number of strong components
+	ID(v int) (int, error)                    // returns the component id of the strong component containing vertex v
+	Size(v int) (int, error)                  // returns the number of vertices in the strong component containing vertex v
+	StronglyConnected(v, w int) (bool, error) // returns true if vertices v and w are in the same strong component
+}
+
 var ErrInvalidVertices = errors.New("number of vertices in a Graph must be non-negative")
 var ErrInvalidVertexIndex = errors.New("invalid vertex index")
+
+// validateVertex validates given vertex index (v) of graph
+func validateVertex(graph AdjacencyGraph, v int) error {
+	if v < 0 || v >= graph.V() {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
+
+// adjacencySize returns the number of vertices in the adjacency list of v
+func adjacencySize(graph AdjacencyGraph, v int) int {
+	n := 0
+	adj, _ := graph.Adj(v)
+	for range adj {
+		n++
+	}
+	return n
+}
+
+// degree returns the degree of vertex v of an undirected graph
+func degree(graph AdjacencyGraph, v int) int {
+	if g, ok := graph.(DegreeGraph); ok {
+		d, _ := g.Degree(v)
+		return d
+	}
+	return adjacencySize(graph, v)
+}
+
+// outDegree returns the out-degree of vertex v of a digraph
+func outDegree(digraph AdjacencyGraph, v int) int {
+	if d, ok := digraph.(DirectedDegreeGraph); ok {
+		out, _ := d.OutDegree(v)
+		return out
+	}
+	return adjacencySize(digraph, v)
+}
+
+// inDegrees returns the in-degrees of all the vertices of a digraph
+func inDegrees(digraph AdjacencyGraph) []int {
+	in := make([]int, digraph.V())
+	if d, ok := digraph.(DirectedDegreeGraph); ok {
+		for v := range in {
+			in[v], _ = d.InDegree(v)
+		}
+		return in
+	}
+	for v := range in {
+		adj, _ := digraph.Adj(v)
+		for w := range adj {
+			in[w]++
+		}
+	}
+	return in
+}
+
+// undirectedEdgeCount returns the number of edges of an undirected graph
+func undirectedEdgeCount(graph AdjacencyGraph) int {
+	if g, ok := graph.(EdgeCountGraph); ok {
+		return g.E()
+	}
+	// the sum of the degrees counts every edge (including self-loops) twice
+	sum := 0
+	for v := 0; v < graph.V(); v++ {
+		sum += degree(graph, v)
+	}
+	return sum / 2
+}
+
+// directedEdgeCount returns the number of edges of a digraph
+func directedEdgeCount(digraph AdjacencyGraph) int {
+	if d, ok := digraph.(EdgeCountGraph); ok {
+		return d.E()
+	}
+	sum := 0
+	for v := 0; v < digraph.V(); v++ {
+		sum += outDegree(digraph, v)
+	}
+	return sum
+}
+
+// reverse returns the reverse of a digraph
+func reverse(digraph AdjacencyGraph) *Digraph {
+	reversed, _ := NewDigraph(digraph.V())
+	for v := 0; v < digraph.V(); v++ {
+		adj, _ := digraph.Adj(v)
+		for w := range adj {
+			reversed.AddEdge(w, v)
+		}
+	}
+	return reversed
+}
+
+// undirectedEdges returns an iterator that iterates over the edges v-w of an undirected graph, each edge once
+// (with v <= w)
+func undirectedEdges(graph AdjacencyGraph) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for v := 0; v < graph.V(); v++ {
+			selfLoops := 0
+			adj, _ := graph.Adj(v)
+			for w := range adj {
+				if w == v {
+					// a self-loop appears twice in the adjacency list
+					selfLoops++
+					if selfLoops%2 == 0 {
+						continue
+					}
+				} else if w < v {
+					continue
+				}
+				if !yield(v, w) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// directedEdges returns an iterator that iterates over the edges v->w of a digraph
+func directedEdges(digraph AdjacencyGraph) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for v := 0; v < digraph.V(); v++ {
+			adj, _ := digraph.Adj(v)
+			for w := range adj {
+				if !yield(v, w) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package graph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The graph readers and writers use the text format of the algs4 data files: the number of vertices V, the number
+// of edges E, and then E edges, each given by its two endpoints followed by its weight (or capacity) for the
+// weighted graphs. Tokens are separated by whitespace (spaces or newlines), so an edge does not have to be on a line
+// of its own; tokens after the last edge are ignored.
+//
+//	3
+//	2
+//	0 1 0.25
+//	1 2 0.5
+
+// ParseError records the line number of a malformed input of a graph reader and the underlying error.
+type ParseError struct {
+	Line int   // line number (starting from 1) of the malformed token
+	Err  error // underlying error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var ErrInvalidEdges = errors.New("number of edges must be non-negative")
+
+// tokenReader splits an input into whitespace-separated tokens and keeps track of the line number of the last token
+type tokenReader struct {
+	scanner *bufio.Scanner
+	fields  []string // remaining tokens of the current line
+	line    int      // current line number
+}
+
+// maxLineSize is the maximum length of an input line, large enough for the adjacency lines of big graphs
+const maxLineSize = 64 << 20
+
+func newTokenReader(r io.Reader) *tokenReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	return &tokenReader{scanner: scanner}
+}
+
+// errorf wraps err into a ParseError at the current line
+func (r *tokenReader) errorf(err error) error {
+	return &ParseError{Line: r.line, Err: err}
+}
+
+func (r *tokenReader) next() (string, error) {
+	for len(r.fields) == 0 {
+		if !r.scanner.Scan() {
+			if err := r.scanner.Err(); err != nil {
+				// the failed line is the one after the last scanned line
+				return "", &ParseError{Line: r.line + 1, Err: err}
+			}
+			return "", r.errorf(io.ErrUnexpectedEOF)
+		}
+		r.line++
+		r.fields = strings.Fields(r.scanner.Text())
+	}
+	token := r.fields[0]
+	r.fields = r.fields[1:]
+	return token, nil
+}
+
+func (r *tokenReader) nextInt() (int, error) {
+	token, err := r.next()
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, r.errorf(err)
+	}
+	return i, nil
+}
+
+func (r *tokenReader) nextFloat() (float64, error) {
+	token, err := r.next()
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return 0, r.errorf(err)
+	}
+	return f, nil
+}
+
+// nextEdge reads the two endpoints of an edge
+func (r *tokenReader) nextEdge() (int, int, error) {
+	v, err := r.nextInt()
+	if err != nil {
+		return 0, 0, err
+	}
+	w, err := r.nextInt()
+	if err != nil {
+		return 0, 0, err
+	}
+	return v, w, nil
+}
+
+// readHeader reads the number of vertices v and creates the graph with newGraph, then reads the number of edges e
+func readHeader[G any](r *tokenReader, newGraph func(v int) (G, error)) (G, int, error) {
+	var zero G
+	v, err := r.nextInt()
+	if err != nil {
+		return zero, 0, err
+	}
+	graph, err := newGraph(v)
+	if err != nil {
+		return zero, 0, r.errorf(err)
+	}
+	e, err := r.nextInt()
+	if err != nil {
+		return zero, 0, err
+	}
+	if e < 0 {
+		return zero, 0, r.errorf(ErrInvalidEdges)
+	}
+	return graph, e, nil
+}
+
+// ReadGraph reads a graph from r, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func ReadGraph(r io.Reader) (*Graph, error) {
+	tokens := newTokenReader(r)
+	graph, e, err := readHeader(tokens, NewGraph)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < e; i++ {
+		v, w, err := tokens.nextEdge()
+		if err != nil {
+			return nil, err
+		}
+		if err = graph.AddEdge(v, w); err != nil {
+			return nil, tokens.errorf(err)
+		}
+	}
+	return graph, nil
+}
+
+// WriteGraph writes graph to w, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteGraph(w io.Writer, graph AdjacencyGraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", graph.V(), undirectedEdgeCount(graph))
+	for v, x := range undirectedEdges(graph) {
+		fmt.Fprintf(bw, "%d %d\n", v, x)
+	}
+	return bw.Flush()
+}
+
+// ReadDigraph reads a digraph from r, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func ReadDigraph(r io.Reader) (*Digraph, error) {
+	tokens := newTokenReader(r)
+	digraph, e, err := readHeader(tokens, NewDigraph)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < e; i++ {
+		v, w, err := tokens.nextEdge()
+		if err != nil {
+			return nil, err
+		}
+		if err = digraph.AddEdge(v, w); err != nil {
+			return nil, tokens.errorf(err)
+		}
+	}
+	return digraph, nil
+}
+
+// WriteDigraph writes digraph to w, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteDigraph(w io.Writer, digraph AdjacencyGraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", digraph.V(), directedEdgeCount(digraph))
+	for v, x := range directedEdges(digraph) {
+		fmt.Fprintf(bw, "%d %d\n", v, x)
+	}
+	return bw.Flush()
+}
+
+// ReadEdgeWeightedGraph reads an edge-weighted graph from r, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func ReadEdgeWeightedGraph(r io.Reader) (*EdgeWeightedGraph, error) {
+	tokens := newTokenReader(r)
+	graph, e, err := readHeader(tokens, NewEdgeWeightedGraph)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < e; i++ {
+		v, w, err := tokens.nextEdge()
+		if err != nil {
+			return nil, err
+		}
+		weight, err := tokens.nextFloat()
+		if err != nil {
+			return nil, err
+		}
+		edge, err := NewEdge(v, w, weight)
+		if err != nil {
+			return nil, tokens.errorf(err)
+		}
+		if err = graph.AddEdge(edge); err != nil {
+			return nil, tokens.errorf(err)
+		}
+	}
+	return graph, nil
+}
+
+// WriteEdgeWeightedGraph writes graph to w, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteEdgeWeightedGraph(w io.Writer, graph *EdgeWeightedGraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", graph.V(), graph.E())
+	for e := range graph.Edges() {
+		v := e.Either()
+		x, _ := e.Other(v)
+		fmt.Fprintf(bw, "%d %d %s\n", v, x, formatWeight(e.Weight()))
+	}
+	return bw.Flush()
+}
+
+// ReadEdgeWeightedDigraph reads an edge-weighted digraph from r, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func ReadEdgeWeightedDigraph(r io.Reader) (*EdgeWeightedDigraph, error) {
+	tokens := newTokenReader(r)
+	digraph, e, err := readHeader(tokens, NewEdgeWeightedDigraph)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < e; i++ {
+		v, w, err := tokens.nextEdge()
+		if err != nil {
+			return nil, err
+		}
+		weight, err := tokens.nextFloat()
+		if err != nil {
+			return nil, err
+		}
+		edge, err := NewDirectedEdge(v, w, weight)
+		if err != nil {
+			return nil, tokens.errorf(err)
+		}
+		if err = digraph.AddEdge(edge); err != nil {
+			return nil, tokens.errorf(err)
+		}
+	}
+	return digraph, nil
+}
+
+// WriteEdgeWeightedDigraph writes digraph to w, in the algs4 text format.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteEdgeWeightedDigraph(w io.Writer, digraph *EdgeWeightedDigraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", digraph.V(), digraph.E())
+	for e := range digraph.Edges() {
+		fmt.Fprintf(bw, "%d %d %s\n", e.From(), e.To(), formatWeight(e.Weight()))
+	}
+	return bw.Flush()
+}
+
+// ReadFlowNetwork reads a flow network from r, in the algs4 text format (with capacities as weights).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func ReadFlowNetwork(r io.Reader) (*FlowNetwork, error) {
+	tokens := newTokenReader(r)
+	network, e, err := readHeader(tokens, NewFlowNetwork)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < e; i++ {
+		v, w, err := tokens.nextEdge()
+		if err != nil {
+			return nil, err
+		}
+		capacity, err := tokens.nextFloat()
+		if err != nil {
+			return nil, err
+		}
+		edge, err := NewFlowEdge(v, w, capacity)
+		if err != nil {
+			return nil, tokens.errorf(err)
+		}
+		if err = network.AddEdge(edge); err != nil {
+			return nil, tokens.errorf(err)
+		}
+	}
+	return network, nil
+}
+
+// WriteFlowNetwork writes network to w, in the algs4 text format (with capacities as weights, flows are not written).
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteFlowNetwork(w io.Writer, network *FlowNetwork) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", network.V(), network.E())
+	for e := range network.Edges() {
+		fmt.Fprintf(bw, "%d %d %s\n", e.From(), e.To(), formatWeight(e.Capacity()))
+	}
+	return bw.Flush()
+}
+
+// formatWeight formats a weight with the fewest digits that read back to the same value
+func formatWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'g', -1, 64)
+}
+
+// The symbol graph readers and writers use the text format of the algs4 symbol graph data files (such as routes.txt
+// or movies.txt): each line is a vertex name followed by the names of its neighbors, separated by a delimiter.
+// Empty lines are ignored.
+//
+//	JFK/MCO/ATL/ORD
+//	ORD/DEN/HOU/DFW/PHX/ATL/LAS
+
+var ErrInvalidDelimiter = errors.New("delimiter must be non-empty")
+var ErrInvalidSymbolName = errors.New("name is empty or contains the delimiter or a line break")
+
+// readSymbolLines reads all the lines of r split by delimiter, and returns them along with the distinct names in
+// order of their first appearance. Empty lines are skipped, and a ParseError wrapping ErrInvalidSymbolName is
+// returned for an empty name, so that every graph read can be written back.
+func readSymbolLines(r io.Reader, delimiter string) ([]string, [][]string, error) {
+	if delimiter == "" {
+		return nil, nil, ErrInvalidDelimiter
+	}
+
+	br := bufio.NewReader(r)
+	seen := make(map[string]bool)
+	var names []string
+	var lines [][]string
+	for number := 1; ; number++ {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, &ParseError{Line: number, Err: err}
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line != "" {
+			fields := strings.Split(line, delimiter)
+			for _, name := range fields {
+				if name == "" {
+					return nil, nil, &ParseError{Line: number, Err: ErrInvalidSymbolName}
+				}
+				if !seen[name] {
+					seen[name] = true
+					names = append(names, name)
+				}
+			}
+			lines = append(lines, fields)
+		}
+		if err == io.EOF {
+			return names, lines, nil
+		}
+	}
+}
+
+// writeSymbolLine writes the name of vertex v followed by the names of the vertices in adj, separated by delimiter
+func writeSymbolLine(bw *bufio.Writer, keys []string, v int, adj []int, delimiter string) error {
+	for i, w := range append([]int{v}, adj...) {
+		name := keys[w]
+		if name == "" || strings.Contains(name, delimiter) || strings.ContainsAny(name, "\r\n") {
+			return ErrInvalidSymbolName
+		}
+		if i > 0 {
+			bw.WriteString(delimiter)
+		}
+		bw.WriteString(name)
+	}
+	bw.WriteByte('\n')
+	return nil
+}
+
+// WriteSymbolGraph writes s to w, in the algs4 symbol graph text format, ErrInvalidSymbolName if a vertex name is
+// empty or contains the delimiter or a line break.
+// The line of each vertex lists its neighbors with smaller indices, so reading the output back with
+// NewSymbolGraphFromReader gives every vertex the same index.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteSymbolGraph(w io.Writer, s *SymbolGraph, delimiter string) error {
+	if delimiter == "" {
+		return ErrInvalidDelimiter
+	}
+
+	bw := bufio.NewWriter(w)
+	for v := 0; v < s.graph.V(); v++ {
+		var adj []int
+		selfLoops := 0
+		for x := range s.graph.adj[v].Iterator() {
+			if x < v {
+				adj = append(adj, x)
+			} else if x == v {
+				// a self-loop appears twice in the adjacency list
+				if selfLoops%2 == 0 {
+					adj = append(adj, x)
+				}
+				selfLoops++
+			}
+		}
+		if err := writeSymbolLine(bw, s.keys, v, adj, delimiter); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteSymbolDigraph writes s to w, in the algs4 symbol graph text format, ErrInvalidSymbolName if a vertex name is
+// empty or contains the delimiter or a line break.
+// The line of each vertex lists its out-neighbors. Reading the output back with NewSymbolDigraphFromReader gives the
+// same digraph, but the vertices may be numbered in a different order.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func WriteSymbolDigraph(w io.Writer, s *SymbolDigraph, delimiter string) error {
+	if delimiter == "" {
+		return ErrInvalidDelimiter
+	}
+
+	bw := bufio.NewWriter(w)
+	for v := 0; v < s.digraph.V(); v++ {
+		var adj []int
+		for x := range s.digraph.adj[v].Iterator() {
+			adj = append(adj, x)
+		}
+		if err := writeSymbolLine(bw, s.keys, v, adj, delimiter); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
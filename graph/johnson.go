@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// Johnson represents a data type for solving the all-pairs shortest paths problem in sparse edge-weighted digraphs
+// with no negative cycles. The edge weights can be positive, negative, or zero.
+// This implementation also determines whether the edge-weighted digraph has a negative cycle and, if so, finds
+// such a cycle.
+// This implementation uses Johnson's algorithm: it computes vertex potentials with BellmanFordSP from an extra
+// vertex connected to every vertex, reweights every edge to a non-negative weight and runs Dijkstra's algorithm
+// from each vertex.
+// It uses O(V²) extra space (not including the edge-weighted digraph), where V is the number of vertices.
+type Johnson struct {
+	potential []float64              // potential[v] = length of shortest path from the extra vertex to v
+	sp        []*DijkstraSP          // sp[s] = shortest paths tree from s using the reweighted edges
+	cycle     iter.Seq[DirectedEdge] // negative cycle (or empty if no such cycle)
+}
+
+// NewJohnson computes a shortest paths tree from each vertex to every other vertex in the edge-weighted digraph,
+// or finds a negative cycle.
+// The complexity is O(V*E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewJohnson(digraph *EdgeWeightedDigraph) *Johnson {
+	v := digraph.V()
+	j := &Johnson{
+		potential: make([]float64, v),
+		sp:        make([]*DijkstraSP, v),
+		cycle:     fundamental.NewStack[DirectedEdge]().Iterator(),
+	}
+
+	// add an extra vertex (v) with a zero-weight edge to every vertex
+	augmented, _ := NewEdgeWeightedDigraph(v + 1)
+	for e := range digraph.Edges() {
+		augmented.AddEdge(e)
+	}
+	for w := 0; w < v; w++ {
+		e, _ := NewDirectedEdge(v, w, 0)
+		augmented.AddEdge(e)
+	}
+	bellmanFord, _ := NewBellmanFordSP(augmented, v)
+	if bellmanFord.HasNegativeCycle() {
+		j.cycle = bellmanFord.NegativeCycle()
+		j.sp = nil
+		return j
+	}
+	for w := 0; w < v; w++ {
+		j.potential[w], _ = bellmanFord.DistTo(w)
+	}
+
+	// reweighted edges are non-negative, rounding errors are clamped to zero
+	reweight := func(e DirectedEdge) float64 {
+		return math.Max(0, e.Weight()+j.potential[e.From()]-j.potential[e.To()])
+	}
+	for s := 0; s < v; s++ {
+		j.sp[s] = newDijkstraSP(digraph, []int{s}, reweight)
+	}
+	return j
+}
+
+// HasNegativeCycle returns true if there is a negative cycle in the edge-weighted digraph.
+// The complexity is O(1).
+func (j *Johnson) HasNegativeCycle() bool {
+	return j.sp == nil
+}
+
+// NegativeCycle returns an iterator that iterates over the edges of a negative cycle, or an empty iterator if
+// there is no such cycle.
+// The complexity is O(1).
+func (j *Johnson) NegativeCycle() iter.Seq[DirectedEdge] {
+	return j.cycle
+}
+
+// HasPath returns true if there is a path from vertex s to vertex t,
+// ErrNegativeCycle if there is a negative cost cycle.
+// The complexity is O(1).
+func (j *Johnson) HasPath(s, t int) (bool, error) {
+	if err := j.validateVertex(s); err != nil {
+		return false, err
+	}
+	if err := j.validateVertex(t); err != nil {
+		return false, err
+	}
+	if j.HasNegativeCycle() {
+		return false, ErrNegativeCycle
+	}
+	return j.sp[s].HasPathTo(t)
+}
+
+// Dist returns the length of a shortest path from vertex s to vertex t, or returns +Inf if there is no path,
+// ErrNegativeCycle if there is a negative cost cycle.
+// The complexity is O(1).
+func (j *Johnson) Dist(s, t int) (float64, error) {
+	if hasPath, err := j.HasPath(s, t); !hasPath {
+		return math.Inf(1), err
+	}
+	dist, _ := j.sp[s].DistTo(t)
+	return dist - j.potential[s] + j.potential[t], nil
+}
+
+// Path returns an iterator that iterates over the edges of a shortest path from vertex s to vertex t,
+// ErrNegativeCycle if there is a negative cost cycle.
+func (j *Johnson) Path(s, t int) (iter.Seq[DirectedEdge], error) {
+	if hasPath, err := j.HasPath(s, t); !hasPath {
+		return fundamental.NewStack[DirectedEdge]().Iterator(), err
+	}
+	return j.sp[s].PathTo(t)
+}
+
+func (j *Johnson) validateVertex(v int) error {
+	if v < 0 || v >= len(j.potential) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -16,14 +16,14 @@ type KosarajuSCC struct {
 
 // NewKosarajuSCC computes the strong components of the digraph.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewKosarajuSCC(digraph *Digraph) *KosarajuSCC {
+func NewKosarajuSCC(digraph AdjacencyGraph) *KosarajuSCC {
 	k := &KosarajuSCC{
 		marked: make([]bool, digraph.V()),
 		id:     make([]int, digraph.V()),
 		size:   make([]int, digraph.V()),
 		count:  0,
 	}
-	dfs := NewDepthFirstOrder(digraph.Reverse())
+	dfs := NewDepthFirstOrder(reverse(digraph))
 	for v := range dfs.ReversePost() {
 		if !k.marked[v] {
 			k.dfs(digraph, v)
@@ -34,7 +34,7 @@ func NewKosarajuSCC(digraph *Digraph) *KosarajuSCC {
 }
 
 // dfs (depth first search) from v
-func (k *KosarajuSCC) dfs(digraph *Digraph, v int) {
+func (k *KosarajuSCC) dfs(digraph AdjacencyGraph, v int) {
 	k.marked[v] = true
 	k.id[v] = k.count
 	k.size[k.count]++
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// KruskalMST represents a data type for computing a minimum spanning tree in an edge-weighted graph.
+// The edge weights can be positive, zero, or negative and need not be distinct. If the graph is not connected,
+// it computes a minimum spanning forest, which is the union of minimum spanning trees in each connected component.
+// This implementation uses Kruskal's algorithm and the union-find data type.
+// It uses O(E) extra space (not including the graph), where E is the number of edges.
+type KruskalMST struct {
+	weight float64                  // weight of MST
+	mst    *fundamental.Queue[Edge] // edges in MST
+}
+
+// NewKruskalMST computes a minimum spanning tree (or forest) of an edge-weighted graph.
+// The complexity is O(E*log(E)), where E is the number of edges.
+func NewKruskalMST(graph *EdgeWeightedGraph) *KruskalMST {
+	k := &KruskalMST{
+		weight: 0,
+		mst:    fundamental.NewQueue[Edge](),
+	}
+
+	pq := fundamental.NewMinPQ[Edge](func(a, b Edge) bool {
+		return a.Compare(b) < 0
+	})
+	for e := range graph.Edges() {
+		pq.Insert(e)
+	}
+
+	// run greedy algorithm
+	uf := fundamental.NewUnionFind(graph.V())
+	for !pq.IsEmpty() && k.mst.Size() < graph.V()-1 {
+		e, _ := pq.DelMin()
+		v := e.Either()
+		w, _ := e.Other(v)
+
+		// v-w does not create a cycle
+		if !uf.Connected(v, w) {
+			uf.Union(v, w)
+			k.mst.Enqueue(e)
+			k.weight += e.Weight()
+		}
+	}
+	return k
+}
+
+// Edges returns an iterator that iterates over the edges in a minimum spanning tree (or forest).
+// The complexity is O(1).
+func (k *KruskalMST) Edges() iter.Seq[Edge] {
+	return k.mst.Iterator()
+}
+
+// Weight returns the sum of the edge weights in a minimum spanning tree (or forest).
+// The complexity is O(1).
+func (k *KruskalMST) Weight() float64 {
+	return k.weight
+}
@@ -0,0 +1,174 @@
+package graph
+
+import "iter"
+
+// LabeledDigraph represents a directed graph, where the vertices are keys of an arbitrary comparable type K (such
+// as IDs or structs).
+// By providing mappings between keys and integers, it serves as a wrapper around the Digraph, which assumes the vertex
+// names are integers between 0 and v - 1. Shortest paths, strong components, topological orders and cycles are
+// available directly in terms of keys with PathTo, StrongComponents, TopologicalOrder and Cycle; the results of the
+// other algorithms, run on Digraph(), are translated back into keys with IndexOf, KeyOf, Indices, Keys, EdgeKeys,
+// PathKeys and ComponentKeys.
+// This implementation uses a symbol table to map from keys to integers, an array to map from integers to keys, and
+// a Digraph to store the underlying digraph.
+type LabeledDigraph[K comparable] struct {
+	labels[K]
+	digraph *Digraph // the underlying digraph
+}
+
+// NewLabeledDigraph initializes a LabeledDigraph with a vertex for each distinct key of keys, in order.
+// The complexity is O(V), where V is the number of vertices (length of keys).
+func NewLabeledDigraph[K comparable](keys []K) *LabeledDigraph[K] {
+	l := newLabels(keys)
+	digraph, _ := NewDigraph(len(l.keys))
+	return &LabeledDigraph[K]{
+		labels:  l,
+		digraph: digraph,
+	}
+}
+
+// Digraph returns a read-only view of the underlying digraph, to run the algorithms on. The view also implements
+// EdgeCountGraph, DirectedDegreeGraph and EdgeQueryGraph, and reflects the later changes of the labeled digraph.
+// The complexity is O(1).
+func (l *LabeledDigraph[K]) Digraph() AdjacencyGraph {
+	return digraphView{digraph: l.digraph}
+}
+
+// AddVertex adds the vertex key if it does not already exist, and returns its integer.
+// The complexity is O(1) (amortized).
+func (l *LabeledDigraph[K]) AddVertex(key K) int {
+	v, added := l.add(key)
+	if added {
+		l.digraph.AddVertex()
+	}
+	return v
+}
+
+// RemoveVertex removes the vertex key and all its incident edges, ErrInvalidKey if key does not exist. The vertices
+// after it are renumbered down by one, along with their keys.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledDigraph[K]) RemoveVertex(key K) error {
+	v, err := l.IndexOf(key)
+	if err != nil {
+		return err
+	}
+	l.digraph.RemoveVertex(v)
+	l.remove(v)
+	return nil
+}
+
+// AddEdge adds the directed edge v->w, ErrInvalidKey if v or w does not exist.
+// The complexity is O(1).
+func (l *LabeledDigraph[K]) AddEdge(v, w K) error {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return err
+	}
+	return l.digraph.AddEdge(vi, wi)
+}
+
+// RemoveEdge removes one copy of the directed edge v->w, ErrInvalidKey if v or w does not exist, ErrEdgeNotFound if
+// there is no such edge.
+// The complexity is O(outdeg(v)).
+func (l *LabeledDigraph[K]) RemoveEdge(v, w K) error {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return err
+	}
+	return l.digraph.RemoveEdge(vi, wi)
+}
+
+// HasEdge returns true if there is the directed edge v->w, ErrInvalidKey if v or w does not exist.
+// The complexity is the same as HasEdge of the underlying digraph.
+func (l *LabeledDigraph[K]) HasEdge(v, w K) (bool, error) {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return false, err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return false, err
+	}
+	return l.digraph.HasEdge(vi, wi)
+}
+
+// Adj returns an iterator that iterates over the keys of the vertices adjacent to vertex key.
+// The complexity is O(1) (Though, iterating over the keys returned by Adj(key) takes time proportional to the
+// out-degree of the vertex).
+func (l *LabeledDigraph[K]) Adj(key K) (iter.Seq[K], error) {
+	v, err := l.IndexOf(key)
+	if err != nil {
+		return nil, err
+	}
+	adj, _ := l.digraph.Adj(v)
+	return l.Keys(adj), nil
+}
+
+// PathTo returns the keys of a shortest directed path (with the fewest edges) from vertex s to vertex t, which is
+// empty if there is no such path, ErrInvalidKey if s or t does not exist.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledDigraph[K]) PathTo(s, t K) (iter.Seq[K], error) {
+	return l.shortestPath(l.digraph, s, t)
+}
+
+// StrongComponents returns the keys of the vertices of each strong component, the i-th group holds the keys of the
+// component with id i of KosarajuSCC.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledDigraph[K]) StrongComponents() [][]K {
+	components, _ := l.ComponentKeys(NewKosarajuSCC(l.digraph).ID)
+	return components
+}
+
+// TopologicalOrder returns the keys of the vertices in a topological order, ErrNotDAG if the digraph is not a DAG.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledDigraph[K]) TopologicalOrder() (iter.Seq[K], error) {
+	order, err := NewTopological(l.digraph).Order()
+	if err != nil {
+		return nil, err
+	}
+	return l.Keys(order), nil
+}
+
+// Cycle returns the keys of a directed cycle in the digraph, which is empty if the digraph is acyclic.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledDigraph[K]) Cycle() iter.Seq[K] {
+	return l.Keys(NewDirectedCycle(l.digraph).Cycle())
+}
+
+// digraphView is a read-only view of a Digraph, so that the vertices cannot be added or removed behind the keys of a
+// LabeledDigraph or the names of a SymbolDigraph
+type digraphView struct {
+	digraph *Digraph
+}
+
+func (d digraphView) V() int {
+	return d.digraph.V()
+}
+
+func (d digraphView) E() int {
+	return d.digraph.E()
+}
+
+func (d digraphView) Adj(v int) (iter.Seq[int], error) {
+	return d.digraph.Adj(v)
+}
+
+func (d digraphView) InDegree(v int) (int, error) {
+	return d.digraph.InDegree(v)
+}
+
+func (d digraphView) OutDegree(v int) (int, error) {
+	return d.digraph.OutDegree(v)
+}
+
+func (d digraphView) HasEdge(v, w int) (bool, error) {
+	return d.digraph.HasEdge(v, w)
+}
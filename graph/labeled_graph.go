@@ -0,0 +1,159 @@
+package graph
+
+import "iter"
+
+// LabeledGraph represents an undirected graph, where the vertices are keys of an arbitrary comparable type K (such
+// as IDs or structs).
+// By providing mappings between keys and integers, it serves as a wrapper around the Graph, which assumes the vertex
+// names are integers between 0 and v - 1. Shortest paths, connected components and cycles are available directly in
+// terms of keys with PathTo, Components and Cycle; the results of the other algorithms, run on Graph(), are
+// translated back into keys with IndexOf, KeyOf, Indices, Keys, EdgeKeys, PathKeys and ComponentKeys.
+// This implementation uses a symbol table to map from keys to integers, an array to map from integers to keys, and
+// a Graph to store the underlying graph.
+type LabeledGraph[K comparable] struct {
+	labels[K]
+	graph *Graph // the underlying graph
+}
+
+// NewLabeledGraph initializes a LabeledGraph with a vertex for each distinct key of keys, in order.
+// The complexity is O(V), where V is the number of vertices (length of keys).
+func NewLabeledGraph[K comparable](keys []K) *LabeledGraph[K] {
+	l := newLabels(keys)
+	graph, _ := NewGraph(len(l.keys))
+	return &LabeledGraph[K]{
+		labels: l,
+		graph:  graph,
+	}
+}
+
+// Graph returns a read-only view of the underlying graph, to run the algorithms on. The view also implements
+// EdgeCountGraph, DegreeGraph and EdgeQueryGraph, and reflects the later changes of the labeled graph.
+// The complexity is O(1).
+func (l *LabeledGraph[K]) Graph() AdjacencyGraph {
+	return graphView{graph: l.graph}
+}
+
+// AddVertex adds the vertex key if it does not already exist, and returns its integer.
+// The complexity is O(1) (amortized).
+func (l *LabeledGraph[K]) AddVertex(key K) int {
+	v, added := l.add(key)
+	if added {
+		l.graph.AddVertex()
+	}
+	return v
+}
+
+// RemoveVertex removes the vertex key and all its incident edges, ErrInvalidKey if key does not exist. The vertices
+// after it are renumbered down by one, along with their keys.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledGraph[K]) RemoveVertex(key K) error {
+	v, err := l.IndexOf(key)
+	if err != nil {
+		return err
+	}
+	l.graph.RemoveVertex(v)
+	l.remove(v)
+	return nil
+}
+
+// AddEdge adds the edge v-w, ErrInvalidKey if v or w does not exist.
+// The complexity is O(1).
+func (l *LabeledGraph[K]) AddEdge(v, w K) error {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return err
+	}
+	return l.graph.AddEdge(vi, wi)
+}
+
+// RemoveEdge removes one copy of the edge v-w, ErrInvalidKey if v or w does not exist, ErrEdgeNotFound if there is
+// no such edge.
+// The complexity is O(deg(v) + deg(w)).
+func (l *LabeledGraph[K]) RemoveEdge(v, w K) error {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return err
+	}
+	return l.graph.RemoveEdge(vi, wi)
+}
+
+// HasEdge returns true if there is the edge v-w, ErrInvalidKey if v or w does not exist.
+// The complexity is the same as HasEdge of the underlying graph.
+func (l *LabeledGraph[K]) HasEdge(v, w K) (bool, error) {
+	vi, err := l.IndexOf(v)
+	if err != nil {
+		return false, err
+	}
+	wi, err := l.IndexOf(w)
+	if err != nil {
+		return false, err
+	}
+	return l.graph.HasEdge(vi, wi)
+}
+
+// Adj returns an iterator that iterates over the keys of the vertices adjacent to vertex key.
+// The complexity is O(1) (Though, iterating over the keys returned by Adj(key) takes time proportional to the
+// degree of the vertex).
+func (l *LabeledGraph[K]) Adj(key K) (iter.Seq[K], error) {
+	v, err := l.IndexOf(key)
+	if err != nil {
+		return nil, err
+	}
+	adj, _ := l.graph.Adj(v)
+	return l.Keys(adj), nil
+}
+
+// PathTo returns the keys of a shortest path (with the fewest edges) between the vertices s and t, which is empty if
+// there is no such path, ErrInvalidKey if s or t does not exist.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledGraph[K]) PathTo(s, t K) (iter.Seq[K], error) {
+	return l.shortestPath(l.graph, s, t)
+}
+
+// Components returns the keys of the vertices of each connected component, the i-th group holds the keys of the
+// component with id i of ConnectedComponents.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledGraph[K]) Components() [][]K {
+	components, _ := l.ComponentKeys(NewConnectedComponents(l.graph).ID)
+	return components
+}
+
+// Cycle returns the keys of a cycle in the graph, which is empty if the graph is acyclic.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (l *LabeledGraph[K]) Cycle() iter.Seq[K] {
+	return l.Keys(NewCycle(l.graph).Cycle())
+}
+
+// graphView is a read-only view of a Graph, so that the vertices cannot be added or removed behind the keys of a
+// LabeledGraph or the names of a SymbolGraph
+type graphView struct {
+	graph *Graph
+}
+
+func (g graphView) V() int {
+	return g.graph.V()
+}
+
+func (g graphView) E() int {
+	return g.graph.E()
+}
+
+func (g graphView) Adj(v int) (iter.Seq[int], error) {
+	return g.graph.Adj(v)
+}
+
+func (g graphView) Degree(v int) (int, error) {
+	return g.graph.Degree(v)
+}
+
+func (g graphView) HasEdge(v, w int) (bool, error) {
+	return g.graph.HasEdge(v, w)
+}
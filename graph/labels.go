@@ -0,0 +1,172 @@
+package graph
+
+import (
+	"errors"
+	"iter"
+)
+
+// labels maps keys of an arbitrary comparable type to the vertex indices 0 through v - 1 and back, and translates
+// the results of the algorithms (which are in terms of vertex indices) into keys.
+// It is shared by LabeledGraph and LabeledDigraph.
+type labels[K comparable] struct {
+	st   map[K]int // a symbol table that maps keys to indices
+	keys []K       // a slice that maps indices to keys
+}
+
+var ErrInvalidKey = errors.New("key does not exist")
+
+// newLabels initializes labels from keys, ignoring duplicated keys
+func newLabels[K comparable](keys []K) labels[K] {
+	l := labels[K]{st: make(map[K]int, len(keys))}
+	for _, key := range keys {
+		l.add(key)
+	}
+	return l
+}
+
+// add maps key to the next index if key does not exist, and returns the index of key and true if it was added
+func (l *labels[K]) add(key K) (int, bool) {
+	if index, ok := l.st[key]; ok {
+		return index, false
+	}
+	l.st[key] = len(l.keys)
+	l.keys = append(l.keys, key)
+	return len(l.keys) - 1, true
+}
+
+// remove removes the key of vertex v, and renumbers the keys after it down by one
+func (l *labels[K]) remove(v int) {
+	l.keys = removeKey(l.st, l.keys, v)
+}
+
+// removeKey removes the key of vertex v from st and keys, and renumbers the keys after it down by one
+func removeKey[K comparable](st map[K]int, keys []K, v int) []K {
+	delete(st, keys[v])
+	keys = append(keys[:v], keys[v+1:]...)
+	for i := v; i < len(keys); i++ {
+		st[keys[i]] = i
+	}
+	return keys
+}
+
+// Contains returns true if the graph contains the vertex key.
+// The complexity is O(1).
+func (l *labels[K]) Contains(key K) bool {
+	_, ok := l.st[key]
+	return ok
+}
+
+// IndexOf returns the integer associated with the vertex key.
+// The complexity is O(1).
+func (l *labels[K]) IndexOf(key K) (int, error) {
+	index, ok := l.st[key]
+	if !ok {
+		return -1, ErrInvalidKey
+	}
+	return index, nil
+}
+
+// KeyOf returns the key of the vertex associated with the integer v.
+// The complexity is O(1).
+func (l *labels[K]) KeyOf(v int) (K, error) {
+	if v < 0 || v >= len(l.keys) {
+		var key K
+		return key, ErrInvalidVertexIndex
+	}
+	return l.keys[v], nil
+}
+
+// Indices returns the integers associated with the vertex keys, for example the sources of a multi-source
+// algorithm.
+// The complexity is O(N), where N is the number of keys.
+func (l *labels[K]) Indices(keys ...K) ([]int, error) {
+	indices := make([]int, len(keys))
+	for i, key := range keys {
+		index, err := l.IndexOf(key)
+		if err != nil {
+			return nil, err
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// Keys returns an iterator that iterates over the keys of vertices, for example a cycle or a topological order.
+// The iteration stops at the first vertex that is not a valid index.
+// The complexity is O(1) (Though, iterating over the keys takes time proportional to the number of vertices).
+func (l *labels[K]) Keys(vertices iter.Seq[int]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for v := range vertices {
+			if v < 0 || v >= len(l.keys) || !yield(l.keys[v]) {
+				return
+			}
+		}
+	}
+}
+
+// EdgeKeys returns an iterator that iterates over the keys of the endpoints of edges, for example the bridges of
+// a graph or the edges of a matching. The iteration stops at the first edge with an endpoint that is not a valid
+// index.
+// The complexity is O(1) (Though, iterating over the keys takes time proportional to the number of edges).
+func (l *labels[K]) EdgeKeys(edges iter.Seq2[int, int]) iter.Seq2[K, K] {
+	return func(yield func(K, K) bool) {
+		for v, w := range edges {
+			if v < 0 || v >= len(l.keys) || w < 0 || w >= len(l.keys) || !yield(l.keys[v], l.keys[w]) {
+				return
+			}
+		}
+	}
+}
+
+// PathKeys returns the keys of the path to the vertex key, using the PathTo method of a path-finding algorithm,
+// for example:
+//
+//	paths, _ := NewDepthFirstPath(labeled.Graph(), s)
+//	path, err := labeled.PathKeys(paths.PathTo, key)
+//
+// The complexity is the complexity of pathTo.
+func (l *labels[K]) PathKeys(pathTo func(v int) (iter.Seq[int], error), key K) (iter.Seq[K], error) {
+	v, err := l.IndexOf(key)
+	if err != nil {
+		return nil, err
+	}
+	path, err := pathTo(v)
+	if err != nil {
+		return nil, err
+	}
+	return l.Keys(path), nil
+}
+
+// ComponentKeys groups the vertex keys by the component id of their vertices, using the ID method of a component
+// algorithm such as ConnectedComponents.ID or TarjanSCC.ID, the i-th group holds the keys of the component with
+// id i.
+// The complexity is O(V) (not including id), where V is the number of vertices.
+func (l *labels[K]) ComponentKeys(id func(v int) (int, error)) ([][]K, error) {
+	var components [][]K
+	for v, key := range l.keys {
+		i, err := id(v)
+		if err != nil {
+			return nil, err
+		}
+		for len(components) <= i {
+			components = append(components, nil)
+		}
+		components[i] = append(components[i], key)
+	}
+	return components, nil
+}
+
+// shortestPath returns the keys of a shortest path from s to t in graph, found by breadth-first search
+func (l *labels[K]) shortestPath(graph AdjacencyGraph, s, t K) (iter.Seq[K], error) {
+	si, err := l.IndexOf(s)
+	if err != nil {
+		return nil, err
+	}
+	ti, err := l.IndexOf(t)
+	if err != nil {
+		return nil, err
+	}
+	paths, _ := NewBreadthFirstPath(graph, si)
+	path, _ := paths.PathTo(ti)
+	return l.Keys(path), nil
+}
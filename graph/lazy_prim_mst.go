@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// LazyPrimMST represents a data type for computing a minimum spanning tree in an edge-weighted graph.
+// The edge weights can be positive, zero, or negative and need not be distinct. If the graph is not connected,
+// it computes a minimum spanning forest, which is the union of minimum spanning trees in each connected component.
+// This implementation uses a lazy version of Prim's algorithm with a binary heap of edges.
+// It uses O(E) extra space (not including the graph), where E is the number of edges.
+type LazyPrimMST struct {
+	weight float64                  // total weight of MST
+	mst    *fundamental.Queue[Edge] // edges in the MST
+	marked []bool                   // marked[v] = true iff v on tree
+	pq     *fundamental.MinPQ[Edge] // edges with one endpoint in tree
+}
+
+// NewLazyPrimMST computes a minimum spanning tree (or forest) of an edge-weighted graph.
+// The complexity is O(E*log(E)), where E is the number of edges.
+func NewLazyPrimMST(graph *EdgeWeightedGraph) *LazyPrimMST {
+	l := &LazyPrimMST{
+		weight: 0,
+		mst:    fundamental.NewQueue[Edge](),
+		marked: make([]bool, graph.V()),
+		pq: fundamental.NewMinPQ[Edge](func(a, b Edge) bool {
+			return a.Compare(b) < 0
+		}),
+	}
+	// run Prim from all vertices to get a minimum spanning forest
+	for v := 0; v < graph.V(); v++ {
+		if !l.marked[v] {
+			l.prim(graph, v)
+		}
+	}
+	return l
+}
+
+// prim runs Prim's algorithm from s
+func (l *LazyPrimMST) prim(graph *EdgeWeightedGraph, s int) {
+	l.scan(graph, s)
+	for !l.pq.IsEmpty() {
+		e, _ := l.pq.DelMin()
+		v := e.Either()
+		w, _ := e.Other(v)
+
+		// lazy, both v and w already scanned
+		if l.marked[v] && l.marked[w] {
+			continue
+		}
+		l.mst.Enqueue(e)
+		l.weight += e.Weight()
+		if !l.marked[v] {
+			l.scan(graph, v)
+		}
+		if !l.marked[w] {
+			l.scan(graph, w)
+		}
+	}
+}
+
+// scan adds all edges e incident to v onto pq if the other endpoint has not yet been scanned
+func (l *LazyPrimMST) scan(graph *EdgeWeightedGraph, v int) {
+	l.marked[v] = true
+	adj, _ := graph.Adj(v)
+	for e := range adj {
+		w, _ := e.Other(v)
+		if !l.marked[w] {
+			l.pq.Insert(e)
+		}
+	}
+}
+
+// Edges returns an iterator that iterates over the edges in a minimum spanning tree (or forest).
+// The complexity is O(1).
+func (l *LazyPrimMST) Edges() iter.Seq[Edge] {
+	return l.mst.Iterator()
+}
+
+// Weight returns the sum of the edge weights in a minimum spanning tree (or forest).
+// The complexity is O(1).
+func (l *LazyPrimMST) Weight() float64 {
+	return l.weight
+}
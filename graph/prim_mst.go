@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+	"math"
+)
+
+// PrimMST represents a data type for computing a minimum spanning tree in an edge-weighted graph.
+// The edge weights can be positive, zero, or negative and need not be distinct. If the graph is not connected,
+// it computes a minimum spanning forest, which is the union of minimum spanning trees in each connected component.
+// This implementation uses the eager version of Prim's algorithm with an indexed binary heap.
+// It uses O(V) extra space (not including the graph), where V is the number of vertices.
+type PrimMST struct {
+	edgeTo []*Edge                          // edgeTo[v] = shortest edge from tree vertex to non-tree vertex
+	distTo []float64                        // distTo[v] = weight of shortest such edge
+	marked []bool                           // marked[v] = true if v on tree, false otherwise
+	pq     *fundamental.IndexMinPQ[float64] // eligible crossing edges
+}
+
+// NewPrimMST computes a minimum spanning tree (or forest) of an edge-weighted graph.
+// The complexity is O(E*log(V)), where V is the number of vertices and E is the number of edges.
+func NewPrimMST(graph *EdgeWeightedGraph) *PrimMST {
+	pq, _ := fundamental.NewIndexMinPQ[float64](graph.V(), func(a, b float64) bool {
+		return a < b
+	})
+	p := &PrimMST{
+		edgeTo: make([]*Edge, graph.V()),
+		distTo: make([]float64, graph.V()),
+		marked: make([]bool, graph.V()),
+		pq:     pq,
+	}
+	for v := 0; v < graph.V(); v++ {
+		p.distTo[v] = math.Inf(1)
+	}
+	// run from each vertex to find minimum spanning forest
+	for v := 0; v < graph.V(); v++ {
+		if !p.marked[v] {
+			p.prim(graph, v)
+		}
+	}
+	return p
+}
+
+// prim runs Prim's algorithm in graph, starting from vertex s
+func (p *PrimMST) prim(graph *EdgeWeightedGraph, s int) {
+	p.distTo[s] = 0
+	p.pq.Insert(s, p.distTo[s])
+	for !p.pq.IsEmpty() {
+		v, _ := p.pq.DelMin()
+		p.scan(graph, v)
+	}
+}
+
+// scan vertex v
+func (p *PrimMST) scan(graph *EdgeWeightedGraph, v int) {
+	p.marked[v] = true
+	adj, _ := graph.Adj(v)
+	for e := range adj {
+		w, _ := e.Other(v)
+		if p.marked[w] {
+			// v-w is obsolete edge
+			continue
+		}
+		if e.Weight() < p.distTo[w] {
+			p.distTo[w] = e.Weight()
+			p.edgeTo[w] = &e
+			if p.pq.Contains(w) {
+				p.pq.DecreaseKey(w, p.distTo[w])
+			} else {
+				p.pq.Insert(w, p.distTo[w])
+			}
+		}
+	}
+}
+
+// Edges returns an iterator that iterates over the edges in a minimum spanning tree (or forest).
+// The complexity is O(V), where V is the number of vertices.
+func (p *PrimMST) Edges() iter.Seq[Edge] {
+	mst := fundamental.NewQueue[Edge]()
+	for v := 0; v < len(p.edgeTo); v++ {
+		if p.edgeTo[v] != nil {
+			mst.Enqueue(*p.edgeTo[v])
+		}
+	}
+	return mst.Iterator()
+}
+
+// Weight returns the sum of the edge weights in a minimum spanning tree (or forest).
+// The complexity is O(V), where V is the number of vertices.
+func (p *PrimMST) Weight() float64 {
+	weight := 0.0
+	for e := range p.Edges() {
+		weight += e.Weight()
+	}
+	return weight
+}
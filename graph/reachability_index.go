@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"github.com/inpour/algorithms/fundamental"
+	"sync"
+)
+
+// ReachabilityIndex represents a data type for answering reachability queries (is there a directed path from v
+// to w?) in a digraph without the quadratic space of TransitiveClosure.
+// This implementation collapses each strong component into a vertex of the condensation DAG (see NewCondensation)
+// and labels the DAG with a depth-first search:
+//   - a tree interval [pre, last] where w is reachable from v if w is a descendant of v in the depth-first forest;
+//   - an interval [low, rank] of postorder ranks where w is not reachable from v if w's interval is not contained
+//     in v's interval.
+//
+// Only the queries that are decided by neither label fall back to a depth-first search, which is pruned by the
+// same labels, so most queries on large DAGs are answered in constant time. The fallback searches take their marker
+// arrays from a pool, so that they are not allocated on every query and the index can be queried concurrently.
+// It uses O(V + E) extra space (not including the digraph), where V is the number of vertices and E is the number
+// of edges.
+type ReachabilityIndex struct {
+	id      []int     // id[v] = strong component containing v
+	dag     *Digraph  // condensation DAG
+	marked  []bool    // marked[c] = has component c been visited by the labeling dfs?
+	pre     []int     // pre[c] = preorder number of component c in the depth-first forest
+	last    []int     // last[c] = largest preorder number in the subtree of component c
+	rank    []int     // rank[c] = postorder rank of component c
+	low     []int     // low[c] = smallest postorder rank of any component reachable from c
+	counter int       // preorder and postorder counter
+	markers sync.Pool // pool of *reachabilityMarker for the fallback searches
+}
+
+// reachabilityMarker marks the components visited by the fallback searches of a ReachabilityIndex, the components
+// visited by the current search are those stamped with the current query number, so it never has to be cleared
+type reachabilityMarker struct {
+	visited []int // visited[c] = query number of the last fallback search that visited component c
+	query   int   // number of fallback searches so far
+}
+
+// NewReachabilityIndex builds the reachability index of the digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewReachabilityIndex(digraph AdjacencyGraph) *ReachabilityIndex {
+	scc := NewKosarajuSCC(digraph)
+	// scc is computed on digraph, so the condensation cannot fail
+	dag, _ := NewCondensation(digraph, scc)
+	r := &ReachabilityIndex{
+		id:     make([]int, digraph.V()),
+		dag:    dag,
+		marked: make([]bool, scc.Count()),
+		pre:    make([]int, scc.Count()),
+		last:   make([]int, scc.Count()),
+		rank:   make([]int, scc.Count()),
+		low:    make([]int, scc.Count()),
+	}
+	r.markers.New = func() any {
+		return &reachabilityMarker{visited: make([]int, scc.Count())}
+	}
+	for v := 0; v < digraph.V(); v++ {
+		r.id[v], _ = scc.ID(v)
+	}
+
+	// label preorder intervals
+	for c := 0; c < r.dag.V(); c++ {
+		if !r.marked[c] {
+			r.dfsPre(c)
+		}
+	}
+
+	// label postorder intervals
+	r.counter = 0
+	for c := 0; c < r.dag.V(); c++ {
+		r.marked[c] = false
+	}
+	for c := 0; c < r.dag.V(); c++ {
+		if !r.marked[c] {
+			r.dfsPost(c)
+		}
+	}
+	return r
+}
+
+// dfsPre (depth first search) from c, computing the tree intervals
+func (r *ReachabilityIndex) dfsPre(c int) {
+	r.marked[c] = true
+	r.pre[c] = r.counter
+	r.counter++
+	adj, _ := r.dag.Adj(c)
+	for d := range adj {
+		if !r.marked[d] {
+			r.dfsPre(d)
+		}
+	}
+	r.last[c] = r.counter - 1
+}
+
+// dfsPost (depth first search) from c, computing the postorder intervals
+func (r *ReachabilityIndex) dfsPost(c int) {
+	r.marked[c] = true
+	low := r.dag.V()
+	adj, _ := r.dag.Adj(c)
+	for d := range adj {
+		if !r.marked[d] {
+			r.dfsPost(d)
+		}
+		low = min(low, r.low[d])
+	}
+	r.rank[c] = r.counter
+	r.counter++
+	r.low[c] = min(low, r.rank[c])
+}
+
+// isDescendant returns true if d is in the subtree of c in the depth-first forest
+func (r *ReachabilityIndex) isDescendant(c, d int) bool {
+	return r.pre[c] <= r.pre[d] && r.pre[d] <= r.last[c]
+}
+
+// mayReach returns false if d is certainly not reachable from c
+func (r *ReachabilityIndex) mayReach(c, d int) bool {
+	return r.low[c] <= r.low[d] && r.rank[d] <= r.rank[c]
+}
+
+// Reachable returns true if there is a directed path from vertex v to vertex w in the digraph.
+// The complexity is O(1) for most queries, and O(V + E) in the worst case.
+func (r *ReachabilityIndex) Reachable(v, w int) (bool, error) {
+	if err := r.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := r.validateVertex(w); err != nil {
+		return false, err
+	}
+	source, target := r.id[v], r.id[w]
+	if r.isDescendant(source, target) {
+		return true, nil
+	}
+	if !r.mayReach(source, target) {
+		return false, nil
+	}
+
+	// undecided, search the DAG skipping components that cannot reach the target
+	m := r.markers.Get().(*reachabilityMarker)
+	defer r.markers.Put(m)
+	m.query++
+	stack := fundamental.NewStack[int]()
+	m.visited[source] = m.query
+	stack.Push(source)
+	for !stack.IsEmpty() {
+		c, _ := stack.Pop()
+		adj, _ := r.dag.Adj(c)
+		for d := range adj {
+			if r.isDescendant(d, target) {
+				return true, nil
+			}
+			if m.visited[d] != m.query && r.mayReach(d, target) {
+				m.visited[d] = m.query
+				stack.Push(d)
+			}
+		}
+	}
+	return false, nil
+}
+
+func (r *ReachabilityIndex) validateVertex(v int) error {
+	if v < 0 || v >= len(r.id) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
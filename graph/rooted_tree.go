@@ -0,0 +1,245 @@
+package graph
+
+import (
+	"errors"
+	"github.com/inpour/algorithms/fundamental"
+	"iter"
+)
+
+// RootedTree represents a rooted-tree view of an undirected graph that is a tree (connected and acyclic, with no
+// self-loops or parallel edges).
+// It supports parent, depth and subtree size queries, lowest common ancestor (LCA) and distance queries, and
+// computes the diameter and the center of the tree.
+// This implementation uses breadth-first search from the root and binary lifting for the ancestor queries.
+// It uses O(V*log(V)) extra space (not including the graph), where V is the number of vertices.
+type RootedTree struct {
+	root        int                     // root vertex
+	parent      []int                   // parent[v] = parent of v, -1 for the root
+	depth       []int                   // depth[v] = number of edges on the root-v path
+	subtreeSize []int                   // subtreeSize[v] = number of vertices in the subtree rooted at v
+	up          [][]int                 // up[k][v] = 2^k-th ancestor of v, -1 if there is no such ancestor
+	diameter    *fundamental.Queue[int] // vertices on a longest path of the tree
+}
+
+// NewRootedTree validates that the graph is a tree and roots it at vertex root, ErrNotTree if the graph is not a tree.
+// The complexity is O(V*log(V)), where V is the number of vertices.
+func NewRootedTree(graph AdjacencyGraph, root int) (*RootedTree, error) {
+	if err := validateVertex(graph, root); err != nil {
+		return nil, err
+	}
+	if undirectedEdgeCount(graph) != graph.V()-1 || NewCycle(graph).HasCycle() || NewConnectedComponents(graph).Count() != 1 {
+		return nil, ErrNotTree
+	}
+
+	n := graph.V()
+	t := &RootedTree{
+		root:        root,
+		parent:      make([]int, n),
+		depth:       make([]int, n),
+		subtreeSize: make([]int, n),
+		diameter:    fundamental.NewQueue[int](),
+	}
+
+	// bfs (breadth first search) from the root, a vertex is visited after its parent
+	order := make([]int, 0, n)
+	t.parent[root] = -1
+	order = append(order, root)
+	for i := 0; i < len(order); i++ {
+		v := order[i]
+		adj, _ := graph.Adj(v)
+		for w := range adj {
+			if w != t.parent[v] {
+				t.parent[w] = v
+				t.depth[w] = t.depth[v] + 1
+				order = append(order, w)
+			}
+		}
+	}
+
+	// accumulate subtree sizes from the leaves up
+	for i := n - 1; i >= 0; i-- {
+		v := order[i]
+		t.subtreeSize[v]++
+		if t.parent[v] != -1 {
+			t.subtreeSize[t.parent[v]] += t.subtreeSize[v]
+		}
+	}
+
+	// binary lifting table
+	t.up = [][]int{t.parent}
+	for k := 1; 1<<k < n; k++ {
+		prev := t.up[k-1]
+		next := make([]int, n)
+		for v := 0; v < n; v++ {
+			next[v] = -1
+			if prev[v] != -1 {
+				next[v] = prev[prev[v]]
+			}
+		}
+		t.up = append(t.up, next)
+	}
+
+	t.findDiameter(graph)
+	return t, nil
+}
+
+var ErrNotTree = errors.New("graph is not a tree")
+
+// findDiameter finds a longest path with two breadth-first searches: the farthest vertex from any vertex is an
+// endpoint of a longest path
+func (t *RootedTree) findDiameter(graph AdjacencyGraph) {
+	first, _ := NewBreadthFirstPath(graph, t.root)
+	a := t.farthest(first)
+	second, _ := NewBreadthFirstPath(graph, a)
+	b := t.farthest(second)
+	path, _ := second.PathTo(b)
+	for v := range path {
+		t.diameter.Enqueue(v)
+	}
+}
+
+// farthest returns a vertex with the largest distance in paths
+func (t *RootedTree) farthest(paths *BreadthFirstPath) int {
+	farthest, maxDist := 0, -1
+	for v := 0; v < len(t.parent); v++ {
+		if dist, _ := paths.DistTo(v); dist > maxDist {
+			farthest, maxDist = v, dist
+		}
+	}
+	return farthest
+}
+
+// Root returns the root vertex.
+// The complexity is O(1).
+func (t *RootedTree) Root() int {
+	return t.root
+}
+
+// Parent returns the parent of vertex v, -1 if v is the root.
+// The complexity is O(1).
+func (t *RootedTree) Parent(v int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return t.parent[v], nil
+}
+
+// Depth returns the number of edges on the path between the root and vertex v.
+// The complexity is O(1).
+func (t *RootedTree) Depth(v int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return t.depth[v], nil
+}
+
+// SubtreeSize returns the number of vertices in the subtree rooted at vertex v (including v).
+// The complexity is O(1).
+func (t *RootedTree) SubtreeSize(v int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	return t.subtreeSize[v], nil
+}
+
+// Ancestor returns the k-th ancestor of vertex v (the 0-th ancestor is v itself), -1 if v has no such ancestor.
+// The complexity is O(log(V)), where V is the number of vertices.
+func (t *RootedTree) Ancestor(v, k int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	if k < 0 || k > t.depth[v] {
+		return -1, nil
+	}
+	return t.ancestor(v, k), nil
+}
+
+// ancestor returns the k-th ancestor of v, where 0 <= k <= depth[v]
+func (t *RootedTree) ancestor(v, k int) int {
+	for i := 0; k > 0; i++ {
+		if k&1 == 1 {
+			v = t.up[i][v]
+		}
+		k >>= 1
+	}
+	return v
+}
+
+// LCA returns the lowest common ancestor of vertices v and w, the deepest vertex that is an ancestor of both.
+// The complexity is O(log(V)), where V is the number of vertices.
+func (t *RootedTree) LCA(v, w int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	if err := t.validateVertex(w); err != nil {
+		return -1, err
+	}
+	return t.lca(v, w), nil
+}
+
+func (t *RootedTree) lca(v, w int) int {
+	// lift the deeper vertex to the same depth
+	if t.depth[v] < t.depth[w] {
+		v, w = w, v
+	}
+	v = t.ancestor(v, t.depth[v]-t.depth[w])
+	if v == w {
+		return v
+	}
+
+	// lift both vertices to just below their lowest common ancestor
+	for k := len(t.up) - 1; k >= 0; k-- {
+		if t.up[k][v] != t.up[k][w] {
+			v = t.up[k][v]
+			w = t.up[k][w]
+		}
+	}
+	return t.parent[v]
+}
+
+// Distance returns the number of edges on the path between vertices v and w.
+// The complexity is O(log(V)), where V is the number of vertices.
+func (t *RootedTree) Distance(v, w int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return -1, err
+	}
+	if err := t.validateVertex(w); err != nil {
+		return -1, err
+	}
+	return t.depth[v] + t.depth[w] - 2*t.depth[t.lca(v, w)], nil
+}
+
+// Diameter returns the number of edges on a longest path of the tree.
+// The complexity is O(1).
+func (t *RootedTree) Diameter() int {
+	return t.diameter.Size() - 1
+}
+
+// DiameterPath returns an iterator that iterates over the vertices of a longest path of the tree.
+// The complexity is O(1).
+func (t *RootedTree) DiameterPath() iter.Seq[int] {
+	return t.diameter.Iterator()
+}
+
+// Center returns an iterator that iterates over the center of the tree, the one or two vertices that minimize
+// the largest distance to any other vertex (the middle of a longest path).
+// The complexity is O(V), where V is the number of vertices.
+func (t *RootedTree) Center() iter.Seq[int] {
+	center := fundamental.NewQueue[int]()
+	d := t.Diameter()
+	i := 0
+	for v := range t.diameter.Iterator() {
+		if i == d/2 || i == (d+1)/2 {
+			center.Enqueue(v)
+		}
+		i++
+	}
+	return center.Iterator()
+}
+
+func (t *RootedTree) validateVertex(v int) error {
+	if v < 0 || v >= len(t.parent) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "io"
+
 // SymbolDigraph represents a directed graph, where the vertex names are arbitrary strings.
 // By providing mappings between string vertex names and integers, it serves as a wrapper around the Graph,
 // which assumes the vertex names are integers between 0 and v - 1.
@@ -31,6 +33,27 @@ func NewSymbolDigraph(vertexNames []string) *SymbolDigraph {
 	}
 }
 
+// NewSymbolDigraphFromReader initializes a SymbolDigraph from r, in the algs4 symbol graph text format: each line is a
+// vertex name followed by the names of its out-neighbors, separated by delimiter.
+// The vertices are numbered in order of the first appearance of their names. A ParseError wrapping
+// ErrInvalidSymbolName is returned if a line has an empty name, such as from a doubled or trailing delimiter.
+// The complexity is O(V + E + N), where V is the number of vertices, E is the number of edges and N is the length
+// of the input.
+func NewSymbolDigraphFromReader(r io.Reader, delimiter string) (*SymbolDigraph, error) {
+	names, lines, err := readSymbolLines(r, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewSymbolDigraph(names)
+	for _, line := range lines {
+		for _, name := range line[1:] {
+			s.AddEdge(line[0], name)
+		}
+	}
+	return s, nil
+}
+
 // Contains returns true if the graph contain the vertex name.
 // The complexity is O(1).
 func (s *SymbolDigraph) Contains(name string) bool {
@@ -51,17 +74,29 @@ func (s *SymbolDigraph) IndexOf(name string) (int, error) {
 // NameOf returns the name of the vertex associated with the integer v
 // The complexity is O(1).
 func (s *SymbolDigraph) NameOf(v int) (string, error) {
-	if err := s.digraph.validateVertex(v); err != nil {
+	if v < 0 || v >= len(s.keys) {
 		var name string
-		return name, err
+		return name, ErrInvalidVertexIndex
 	}
 	return s.keys[v], nil
 }
 
-// Digraph returns the digraph associated with the symbol graph.
+// Digraph returns a read-only view of the underlying digraph, to run the algorithms on. The view also implements
+// EdgeCountGraph, DirectedDegreeGraph and EdgeQueryGraph, and reflects the later changes of the symbol graph.
 // The complexity is O(1).
-func (s *SymbolDigraph) Digraph() *Digraph {
-	return s.digraph
+func (s *SymbolDigraph) Digraph() AdjacencyGraph {
+	return digraphView{digraph: s.digraph}
+}
+
+// AddVertex adds the vertex name if it does not already exist, and returns its integer.
+// The complexity is O(1) (amortized).
+func (s *SymbolDigraph) AddVertex(name string) int {
+	if v, ok := s.st[name]; ok {
+		return v
+	}
+	s.st[name] = len(s.keys)
+	s.keys = append(s.keys, name)
+	return s.digraph.AddVertex()
 }
 
 // AddEdge adds the edge v-w.
@@ -78,3 +113,30 @@ func (s *SymbolDigraph) AddEdge(v, w string) error {
 	s.digraph.AddEdge(vi, wi)
 	return nil
 }
+
+// HasEdge returns true if there is the directed edge v->w, ErrInvalidName if v or w does not exist.
+// The complexity is the same as HasEdge of the underlying digraph.
+func (s *SymbolDigraph) HasEdge(v, w string) (bool, error) {
+	vi, err := s.IndexOf(v)
+	if err != nil {
+		return false, err
+	}
+	wi, err := s.IndexOf(w)
+	if err != nil {
+		return false, err
+	}
+	return s.digraph.HasEdge(vi, wi)
+}
+
+// RemoveVertex removes the vertex name and all its incident edges. The vertices after it are renumbered down by one,
+// along with their names.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (s *SymbolDigraph) RemoveVertex(name string) error {
+	v, err := s.IndexOf(name)
+	if err != nil {
+		return err
+	}
+	s.digraph.RemoveVertex(v)
+	s.keys = removeKey(s.st, s.keys, v)
+	return nil
+}
@@ -1,6 +1,9 @@
 package graph
 
-import "errors"
+import (
+	"errors"
+	"io"
+)
 
 // SymbolGraph represents an undirected graph, where the vertex names are arbitrary strings.
 // By providing mappings between string vertex names and integers, it serves as a wrapper around the Graph,
@@ -33,6 +36,27 @@ func NewSymbolGraph(vertexNames []string) *SymbolGraph {
 	}
 }
 
+// NewSymbolGraphFromReader initializes a SymbolGraph from r, in the algs4 symbol graph text format: each line is a
+// vertex name followed by the names of its neighbors, separated by delimiter.
+// The vertices are numbered in order of the first appearance of their names. A ParseError wrapping
+// ErrInvalidSymbolName is returned if a line has an empty name, such as from a doubled or trailing delimiter.
+// The complexity is O(V + E + N), where V is the number of vertices, E is the number of edges and N is the length
+// of the input.
+func NewSymbolGraphFromReader(r io.Reader, delimiter string) (*SymbolGraph, error) {
+	names, lines, err := readSymbolLines(r, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewSymbolGraph(names)
+	for _, line := range lines {
+		for _, name := range line[1:] {
+			s.AddEdge(line[0], name)
+		}
+	}
+	return s, nil
+}
+
 var ErrInvalidName = errors.New("name does not exist")
 
 // Contains returns true if the graph contain the vertex name.
@@ -55,17 +79,29 @@ func (s *SymbolGraph) IndexOf(name string) (int, error) {
 // NameOf returns the name of the vertex associated with the integer v
 // The complexity is O(1).
 func (s *SymbolGraph) NameOf(v int) (string, error) {
-	if err := s.graph.validateVertex(v); err != nil {
+	if v < 0 || v >= len(s.keys) {
 		var name string
-		return name, err
+		return name, ErrInvalidVertexIndex
 	}
 	return s.keys[v], nil
 }
 
-// Graph returns the graph associated with the symbol graph.
+// Graph returns a read-only view of the underlying graph, to run the algorithms on. The view also implements
+// EdgeCountGraph, DegreeGraph and EdgeQueryGraph, and reflects the later changes of the symbol graph.
 // The complexity is O(1).
-func (s *SymbolGraph) Graph() *Graph {
-	return s.graph
+func (s *SymbolGraph) Graph() AdjacencyGraph {
+	return graphView{graph: s.graph}
+}
+
+// AddVertex adds the vertex name if it does not already exist, and returns its integer.
+// The complexity is O(1) (amortized).
+func (s *SymbolGraph) AddVertex(name string) int {
+	if v, ok := s.st[name]; ok {
+		return v
+	}
+	s.st[name] = len(s.keys)
+	s.keys = append(s.keys, name)
+	return s.graph.AddVertex()
 }
 
 // AddEdge adds the edge v-w.
@@ -82,3 +118,30 @@ func (s *SymbolGraph) AddEdge(v, w string) error {
 	s.graph.AddEdge(vi, wi)
 	return nil
 }
+
+// HasEdge returns true if there is the edge v-w, ErrInvalidName if v or w does not exist.
+// The complexity is the same as HasEdge of the underlying graph.
+func (s *SymbolGraph) HasEdge(v, w string) (bool, error) {
+	vi, err := s.IndexOf(v)
+	if err != nil {
+		return false, err
+	}
+	wi, err := s.IndexOf(w)
+	if err != nil {
+		return false, err
+	}
+	return s.graph.HasEdge(vi, wi)
+}
+
+// RemoveVertex removes the vertex name and all its incident edges. The vertices after it are renumbered down by one,
+// along with their names.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func (s *SymbolGraph) RemoveVertex(name string) error {
+	v, err := s.IndexOf(name)
+	if err != nil {
+		return err
+	}
+	s.graph.RemoveVertex(v)
+	s.keys = removeKey(s.st, s.keys, v)
+	return nil
+}
@@ -0,0 +1,117 @@
+package graph
+
+import "github.com/inpour/algorithms/fundamental"
+
+// TarjanSCC (Tarjan strongly connected components) represents a data type for determining the
+// strongly connected components (or strong components for short) in a digraph.
+// This implementation uses Tarjan's algorithm, which needs a single depth-first search and no reverse digraph.
+// The component identifier (id) of a vertex is an integer between 0 and k–1, where k is the number
+// of strong components. Two vertices have the same component identifier if and only if they are
+// in the same strong component.
+// It uses O(V) extra space (not including the graph), where V is the number of vertices.
+type TarjanSCC struct {
+	marked []bool                  // marked[v] = has v been visited?
+	id     []int                   // id[v] = id of strong component containing v
+	size   []int                   // size[id] = number of vertices in given strong component
+	low    []int                   // low[v] = low number of v
+	pre    int                     // preorder number counter
+	count  int                     // number of strong components
+	stack  *fundamental.Stack[int] // vertices of the strong components not yet identified
+}
+
+// NewTarjanSCC computes the strong components of the digraph.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewTarjanSCC(digraph AdjacencyGraph) *TarjanSCC {
+	t := &TarjanSCC{
+		marked: make([]bool, digraph.V()),
+		id:     make([]int, digraph.V()),
+		size:   make([]int, digraph.V()),
+		low:    make([]int, digraph.V()),
+		pre:    0,
+		count:  0,
+		stack:  fundamental.NewStack[int](),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		if !t.marked[v] {
+			t.dfs(digraph, v)
+		}
+	}
+	return t
+}
+
+// dfs (depth first search) from v
+func (t *TarjanSCC) dfs(digraph AdjacencyGraph, v int) {
+	t.marked[v] = true
+	t.low[v] = t.pre
+	t.pre++
+	lowest := t.low[v]
+	t.stack.Push(v)
+	adj, _ := digraph.Adj(v)
+	for w := range adj {
+		if !t.marked[w] {
+			t.dfs(digraph, w)
+		}
+		if t.low[w] < lowest {
+			lowest = t.low[w]
+		}
+	}
+	if lowest < t.low[v] {
+		t.low[v] = lowest
+		return
+	}
+
+	// v is the root of a strong component, pop it off the stack
+	for {
+		w, _ := t.stack.Pop()
+		t.id[w] = t.count
+		t.size[t.count]++
+		t.low[w] = len(t.marked)
+		if w == v {
+			break
+		}
+	}
+	t.count++
+}
+
+// ID returns the component id of the strong component containing vertex v.
+// The complexity is O(1).
+func (t *TarjanSCC) ID(v int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return 0, err
+	}
+	return t.id[v], nil
+}
+
+// Size returns the number of vertices in the strong component containing vertex v.
+// The complexity is O(1).
+func (t *TarjanSCC) Size(v int) (int, error) {
+	if err := t.validateVertex(v); err != nil {
+		return 0, err
+	}
+	return t.size[t.id[v]], nil
+}
+
+// Count returns the number of strong components.
+// The complexity is O(1).
+func (t *TarjanSCC) Count() int {
+	return t.count
+}
+
+// StronglyConnected returns true if vertices v and w are in the same strong component.
+// The complexity is O(1).
+func (t *TarjanSCC) StronglyConnected(v, w int) (bool, error) {
+	if err := t.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := t.validateVertex(w); err != nil {
+		return false, err
+	}
+	return t.id[v] == t.id[w], nil
+}
+
+func (t *TarjanSCC) validateVertex(v int) error {
+	if v < 0 || v >= len(t.marked) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ type Topological struct {
 
 // NewTopological determines whether the digraph has a topological order and, if so, finds such a topological order.
 // The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
-func NewTopological(digraph *Digraph) *Topological {
+func NewTopological(digraph AdjacencyGraph) *Topological {
 	t := &Topological{
 		order: nil,
 		rank:  make([]int, digraph.V()),
@@ -34,6 +34,27 @@ func NewTopological(digraph *Digraph) *Topological {
 	return t
 }
 
+// NewEdgeWeightedTopological determines whether the edge-weighted digraph has a topological order and, if so,
+// finds such a topological order.
+// The complexity is O(V + E), where V is the number of vertices and E is the number of edges.
+func NewEdgeWeightedTopological(digraph *EdgeWeightedDigraph) *Topological {
+	t := &Topological{
+		order: nil,
+		rank:  make([]int, digraph.V()),
+	}
+	finder := NewEdgeWeightedDirectedCycle(digraph)
+	if !finder.HasCycle() {
+		dfs := NewEdgeWeightedDepthFirstOrder(digraph)
+		t.order = dfs.ReversePost()
+		i := 0
+		for v := range t.order {
+			t.rank[v] = i
+			i++
+		}
+	}
+	return t
+}
+
 var ErrNotDAG = errors.New("digraph is not a DAG")
 
 // HasOrder returns true if the digraph has a topological order (or equivalently, if the digraph is a DAG).
@@ -0,0 +1,40 @@
+package graph
+
+// TransitiveClosure represents a data type for computing the transitive closure of a digraph.
+// This implementation runs depth-first search from each vertex.
+// It uses O(V²) extra space (not including the digraph), where V is the number of vertices.
+// For large digraphs, ReachabilityIndex answers the same queries in O(V + E) space.
+type TransitiveClosure struct {
+	tc []*DepthFirstSearch // tc[v] = reachability from v
+}
+
+// NewTransitiveClosure computes the transitive closure of the digraph.
+// The complexity is O(V*(V + E)), where V is the number of vertices and E is the number of edges.
+func NewTransitiveClosure(digraph AdjacencyGraph) *TransitiveClosure {
+	t := &TransitiveClosure{
+		tc: make([]*DepthFirstSearch, digraph.V()),
+	}
+	for v := 0; v < digraph.V(); v++ {
+		t.tc[v], _ = NewDepthFirstSearch(digraph, v)
+	}
+	return t
+}
+
+// Reachable returns true if there is a directed path from vertex v to vertex w in the digraph.
+// The complexity is O(1).
+func (t *TransitiveClosure) Reachable(v, w int) (bool, error) {
+	if err := t.validateVertex(v); err != nil {
+		return false, err
+	}
+	if err := t.validateVertex(w); err != nil {
+		return false, err
+	}
+	return t.tc[v].Marked(w)
+}
+
+func (t *TransitiveClosure) validateVertex(v int) error {
+	if v < 0 || v >= len(t.tc) {
+		return ErrInvalidVertexIndex
+	}
+	return nil
+}